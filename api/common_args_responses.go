@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/snow/choices"
 	"github.com/CaiJiJi/avalanchego/utils/formatting"
 
 	avajson "github.com/CaiJiJi/avalanchego/utils/json"
@@ -68,6 +69,9 @@ type JSONSpendHeader struct {
 type GetBlockArgs struct {
 	BlockID  ids.ID              `json:"blockID"`
 	Encoding formatting.Encoding `json:"encoding"`
+	// IncludeTxStatuses requests the status of each tx in the block.
+	// Currently only supported by the AVM.
+	IncludeTxStatuses bool `json:"includeTxStatuses"`
 }
 
 // GetBlockByHeightArgs is the parameters supplied to the GetBlockByHeight API
@@ -84,6 +88,9 @@ type GetBlockResponse struct {
 	// If GetBlockResponse.Encoding is formatting.JSON, GetBlockResponse.Block
 	// is the actual block returned as a JSON.
 	Encoding formatting.Encoding `json:"encoding"`
+	// TxStatuses maps the ID of each tx in the block to its status. It is
+	// only populated if requested with GetBlockArgs.IncludeTxStatuses.
+	TxStatuses map[ids.ID]choices.Status `json:"txStatuses,omitempty"`
 }
 
 type GetHeightResponse struct {
@@ -100,6 +107,12 @@ type FormattedBlock struct {
 type GetTxArgs struct {
 	TxID     ids.ID              `json:"txID"`
 	Encoding formatting.Encoding `json:"encoding"`
+	// If [OmitCredentials] is true and [Encoding] is [JSON], the credentials
+	// of the tx are excluded from the reply.
+	OmitCredentials bool `json:"omitCredentials"`
+	// If [IncludeBlockContext] is true, the reply includes the block that
+	// accepted the tx, if there is one.
+	IncludeBlockContext bool `json:"includeBlockContext"`
 }
 
 // GetTxReply defines an object containing a single [Tx] object along with Encoding
@@ -110,6 +123,16 @@ type GetTxReply struct {
 	// returned as JSON to the caller.
 	Tx       json.RawMessage     `json:"tx"`
 	Encoding formatting.Encoding `json:"encoding"`
+	// BlockContext is only populated if [GetTxArgs.IncludeBlockContext] is
+	// true and the tx was accepted in a block.
+	BlockContext *TxBlockContext `json:"blockContext,omitempty"`
+}
+
+// TxBlockContext identifies the block that accepted a tx
+type TxBlockContext struct {
+	BlockID   ids.ID         `json:"blockID"`
+	Height    avajson.Uint64 `json:"height"`
+	Timestamp avajson.Uint64 `json:"timestamp"`
 }
 
 // FormattedTx defines a JSON formatted struct containing a Tx as a string
@@ -137,12 +160,15 @@ type Index struct {
 // If [StartIndex] is omitted, gets all UTXOs.
 // If GetUTXOs is called multiple times, with our without [StartIndex], it is not guaranteed
 // that returned UTXOs are unique. That is, the same UTXO may appear in the response of multiple calls.
+// If [SpendableOnly], UTXOs that [Addresses] can't currently spend are omitted from each page.
+// [SpendableOnly] is only supported by the AVM.
 type GetUTXOsArgs struct {
-	Addresses   []string            `json:"addresses"`
-	SourceChain string              `json:"sourceChain"`
-	Limit       avajson.Uint32      `json:"limit"`
-	StartIndex  Index               `json:"startIndex"`
-	Encoding    formatting.Encoding `json:"encoding"`
+	Addresses     []string            `json:"addresses"`
+	SourceChain   string              `json:"sourceChain"`
+	Limit         avajson.Uint32      `json:"limit"`
+	StartIndex    Index               `json:"startIndex"`
+	Encoding      formatting.Encoding `json:"encoding"`
+	SpendableOnly bool                `json:"spendableOnly"`
 }
 
 // GetUTXOsReply defines the GetUTXOs replies returned from the API
@@ -157,4 +183,8 @@ type GetUTXOsReply struct {
 	EndIndex Index `json:"endIndex"`
 	// Encoding specifies the encoding format the UTXOs are returned in
 	Encoding formatting.Encoding `json:"encoding"`
+	// TruncatedByDeadline is true if the scan for UTXOs was cut short by the
+	// server's deadline. Fewer than the requested number of UTXOs may have
+	// been returned even if more exist; [EndIndex] continues the scan.
+	TruncatedByDeadline bool `json:"truncatedByDeadline,omitempty"`
 }
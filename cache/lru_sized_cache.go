@@ -8,119 +8,245 @@ import (
 
 	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/utils/linked"
+	"github.com/CaiJiJi/avalanchego/utils/set"
 )
 
-var _ Cacher[struct{}, any] = (*sizedLRU[struct{}, any])(nil)
+var _ Cacher[struct{}, any] = (*SizedLRU[struct{}, any])(nil)
 
-// sizedLRU is a key value store with bounded size. If the size is attempted to
+// SizedLRU is a key value store with bounded size. If the size is attempted to
 // be exceeded, then elements are removed from the cache until the bound is
 // honored, based on evicting the least recently used value.
-type sizedLRU[K comparable, V any] struct {
+//
+// Pinned elements are skipped when evicting elements to honor the bound, but
+// still count towards it. If the pinned elements leave no room for a new
+// element, the cache is flushed, including the pinned elements, as it is when
+// an element larger than the bound is put.
+type SizedLRU[K comparable, V any] struct {
 	lock        sync.Mutex
 	elements    *linked.Hashmap[K, V]
+	pinned      set.Set[K]
 	maxSize     int
 	currentSize int
 	size        func(K, V) int
+	metrics     *SizedLRUMetrics
 }
 
-func NewSizedLRU[K comparable, V any](maxSize int, size func(K, V) int) Cacher[K, V] {
-	return &sizedLRU[K, V]{
+// NewSizedLRU returns a *SizedLRU as a Cacher. Callers that need to resize,
+// pin, or iterate the cache can assert the result to a *SizedLRU.
+func NewSizedLRU[K comparable, V any](
+	maxSize int,
+	size func(K, V) int,
+	options ...SizedLRUOption,
+) Cacher[K, V] {
+	var opts sizedLRUOptions
+	for _, option := range options {
+		option(&opts)
+	}
+	return &SizedLRU[K, V]{
 		elements: linked.NewHashmap[K, V](),
 		maxSize:  maxSize,
 		size:     size,
+		metrics:  opts.metrics,
 	}
 }
 
-func (c *sizedLRU[K, V]) Put(key K, value V) {
+func (c *SizedLRU[K, V]) Put(key K, value V) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	c.put(key, value)
 }
 
-func (c *sizedLRU[K, V]) Get(key K) (V, bool) {
+func (c *SizedLRU[K, V]) Get(key K) (V, bool) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	return c.get(key)
 }
 
-func (c *sizedLRU[K, V]) Evict(key K) {
+func (c *SizedLRU[K, V]) Evict(key K) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	c.evict(key)
 }
 
-func (c *sizedLRU[K, V]) Flush() {
+func (c *SizedLRU[K, V]) Flush() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	c.flush()
 }
 
-func (c *sizedLRU[_, _]) Len() int {
+func (c *SizedLRU[_, _]) Len() int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	return c.len()
 }
 
-func (c *sizedLRU[_, _]) PortionFilled() float64 {
+func (c *SizedLRU[_, _]) PortionFilled() float64 {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	return c.portionFilled()
 }
 
-func (c *sizedLRU[K, V]) put(key K, value V) {
+// Pin exempts the element with [key] from being evicted to honor the maximum
+// size. Pinning a key that isn't in the cache has no effect.
+func (c *SizedLRU[K, _]) Pin(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pin(key)
+}
+
+// Unpin allows the element with [key] to be evicted to honor the maximum size
+// again.
+func (c *SizedLRU[K, _]) Unpin(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pinned.Remove(key)
+}
+
+// Resize sets the maximum size of the cache to [maxSize]. If the cache
+// currently exceeds [maxSize], the least recently used unpinned elements are
+// evicted until the bound is honored. If [maxSize] is not positive, or the
+// pinned elements exceed it, the cache is flushed.
+func (c *SizedLRU[_, _]) Resize(maxSize int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.resize(maxSize)
+}
+
+// ForEach calls [fn] on each element of the cache, from the least recently used
+// to the most recently used, until [fn] returns false. Iterating does not mark
+// any element as recently used.
+//
+// The cache is locked for the duration of the iteration, so [fn] must not call
+// any methods on the cache.
+func (c *SizedLRU[K, V]) ForEach(fn func(K, V) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.forEach(fn)
+}
+
+func (c *SizedLRU[K, V]) put(key K, value V) {
 	newEntrySize := c.size(key, value)
 	if newEntrySize > c.maxSize {
+		c.metrics.evicted(c.elements.Len())
 		c.flush()
 		return
 	}
 
+	// The old value is removed before evicting so that it can't be evicted,
+	// and its size subtracted, a second time. The new value is added as the
+	// most recently used element either way.
 	if oldValue, ok := c.elements.Get(key); ok {
+		c.elements.Delete(key)
 		c.currentSize -= c.size(key, oldValue)
 	}
 
 	// Remove elements until the size of elements in the cache <= [c.maxSize].
-	for c.currentSize > c.maxSize-newEntrySize {
-		oldestKey, oldestValue, _ := c.elements.Oldest()
-		c.elements.Delete(oldestKey)
-		c.currentSize -= c.size(oldestKey, oldestValue)
+	if !c.evictUnpinned(c.maxSize - newEntrySize) {
+		c.metrics.evicted(c.elements.Len())
+		c.flush()
+		return
 	}
 
 	c.elements.Put(key, value)
 	c.currentSize += newEntrySize
+	c.metrics.setSize(c.currentSize)
+}
+
+func (c *SizedLRU[_, _]) resize(maxSize int) {
+	c.maxSize = maxSize
+	if c.maxSize <= 0 {
+		c.metrics.evicted(c.elements.Len())
+		c.flush()
+		return
+	}
+
+	// Remove elements until the size of elements in the cache <= [c.maxSize].
+	if !c.evictUnpinned(c.maxSize) {
+		c.metrics.evicted(c.elements.Len())
+		c.flush()
+		return
+	}
+	c.metrics.setSize(c.currentSize)
 }
 
-func (c *sizedLRU[K, V]) get(key K) (V, bool) {
+// evictUnpinned removes unpinned elements, starting with the least recently
+// used, until the size of elements in the cache <= [maxSize]. It returns false
+// if the pinned elements alone exceed [maxSize].
+func (c *SizedLRU[K, V]) evictUnpinned(maxSize int) bool {
+	it := c.elements.NewIterator()
+	for c.currentSize > maxSize && it.Next() {
+		key := it.Key()
+		if c.pinned.Contains(key) {
+			continue
+		}
+		c.elements.Delete(key)
+		c.currentSize -= c.size(key, it.Value())
+		c.metrics.evicted(1)
+	}
+	return c.currentSize <= maxSize
+}
+
+func (c *SizedLRU[K, _]) pin(key K) {
+	if _, ok := c.elements.Get(key); ok {
+		c.pinned.Add(key)
+	}
+}
+
+func (c *SizedLRU[K, V]) get(key K) (V, bool) {
 	value, ok := c.elements.Get(key)
 	if !ok {
+		c.metrics.miss()
 		return utils.Zero[V](), false
 	}
 
 	c.elements.Put(key, value) // Mark [k] as MRU.
+	c.metrics.hit()
 	return value, true
 }
 
-func (c *sizedLRU[K, _]) evict(key K) {
+func (c *SizedLRU[K, _]) evict(key K) {
 	if value, ok := c.elements.Get(key); ok {
 		c.elements.Delete(key)
+		c.pinned.Remove(key)
 		c.currentSize -= c.size(key, value)
+		c.metrics.setSize(c.currentSize)
 	}
 }
 
-func (c *sizedLRU[K, V]) flush() {
+func (c *SizedLRU[K, V]) flush() {
 	c.elements.Clear()
+	c.pinned.Clear()
 	c.currentSize = 0
+	c.metrics.setSize(0)
 }
 
-func (c *sizedLRU[_, _]) len() int {
+func (c *SizedLRU[K, V]) forEach(fn func(K, V) bool) {
+	it := c.elements.NewIterator()
+	for it.Next() {
+		if !fn(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
+
+func (c *SizedLRU[_, _]) len() int {
 	return c.elements.Len()
 }
 
-func (c *sizedLRU[_, _]) portionFilled() float64 {
+func (c *SizedLRU[_, _]) portionFilled() float64 {
+	// A cache without a positive maximum size is always empty.
+	if c.maxSize <= 0 {
+		return 0
+	}
 	return float64(c.currentSize) / float64(c.maxSize)
 }
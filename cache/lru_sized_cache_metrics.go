@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package cache
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SizedLRUOption configures a SizedLRU.
+type SizedLRUOption func(*sizedLRUOptions)
+
+type sizedLRUOptions struct {
+	metrics *SizedLRUMetrics
+}
+
+// WithMetrics reports the hits, misses, evictions, and size of the cache to
+// [metrics].
+func WithMetrics(metrics *SizedLRUMetrics) SizedLRUOption {
+	return func(o *sizedLRUOptions) {
+		o.metrics = metrics
+	}
+}
+
+// SizedLRUMetrics are the metrics reported by a SizedLRU.
+type SizedLRUMetrics struct {
+	hits      prometheus.Counter
+	misses    prometheus.Counter
+	evictions prometheus.Counter
+	size      prometheus.Gauge
+}
+
+func NewSizedLRUMetrics(
+	namespace string,
+	reg prometheus.Registerer,
+) (*SizedLRUMetrics, error) {
+	m := &SizedLRUMetrics{
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "hits",
+			Help:      "number of get calls that found the key",
+		}),
+		misses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "misses",
+			Help:      "number of get calls that didn't find the key",
+		}),
+		evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "evictions",
+			Help:      "number of entries removed to honor the maximum size",
+		}),
+		size: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "size",
+			Help:      "total size of the entries",
+		}),
+	}
+	return m, errors.Join(
+		reg.Register(m.hits),
+		reg.Register(m.misses),
+		reg.Register(m.evictions),
+		reg.Register(m.size),
+	)
+}
+
+// The methods below are no-ops on a nil receiver, so a SizedLRU without
+// metrics doesn't need to check whether metrics were provided.
+
+func (m *SizedLRUMetrics) hit() {
+	if m != nil {
+		m.hits.Inc()
+	}
+}
+
+func (m *SizedLRUMetrics) miss() {
+	if m != nil {
+		m.misses.Inc()
+	}
+}
+
+func (m *SizedLRUMetrics) evicted(numEvicted int) {
+	if m != nil {
+		m.evictions.Add(float64(numEvicted))
+	}
+}
+
+func (m *SizedLRUMetrics) setSize(size int) {
+	if m != nil {
+		m.size.Set(float64(size))
+	}
+}
@@ -4,8 +4,12 @@
 package cache_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CaiJiJi/avalanchego/cache/cachetest"
@@ -53,3 +57,235 @@ func TestSizedLRUWrongKeyEvictionRegression(t *testing.T) {
 	_, ok = cache.Get("dd")
 	require.True(ok)
 }
+
+func TestSizedLRUResize(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedLRU[ids.ID, int64](4*cachetest.IntSize, cachetest.IntSizeFunc).(*SizedLRU[ids.ID, int64])
+
+	id0 := ids.GenerateTestID()
+	id1 := ids.GenerateTestID()
+	id2 := ids.GenerateTestID()
+	id3 := ids.GenerateTestID()
+
+	cache.Put(id0, 0)
+	cache.Put(id1, 1)
+	cache.Put(id2, 2)
+	cache.Put(id3, 3)
+	require.Equal(4, cache.Len())
+	require.InDelta(1, cache.PortionFilled(), 0)
+
+	// Growing the cache should not evict anything
+	cache.Resize(8 * cachetest.IntSize)
+	require.Equal(4, cache.Len())
+	require.InDelta(.5, cache.PortionFilled(), 0)
+
+	// Mark [id0] as recently used
+	_, ok := cache.Get(id0)
+	require.True(ok)
+
+	// Shrinking the cache should evict exactly enough of the least recently
+	// used elements
+	cache.Resize(2 * cachetest.IntSize)
+	require.Equal(2, cache.Len())
+	require.InDelta(1, cache.PortionFilled(), 0)
+
+	_, ok = cache.Get(id1)
+	require.False(ok)
+	_, ok = cache.Get(id2)
+	require.False(ok)
+	_, ok = cache.Get(id3)
+	require.True(ok)
+	_, ok = cache.Get(id0)
+	require.True(ok)
+
+	// Shrinking the cache to a non-positive size should flush it
+	cache.Resize(0)
+	require.Zero(cache.Len())
+	require.Zero(cache.PortionFilled())
+
+	_, ok = cache.Get(id0)
+	require.False(ok)
+}
+
+func TestSizedLRUForEach(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedLRU[ids.ID, int64](4*cachetest.IntSize, cachetest.IntSizeFunc).(*SizedLRU[ids.ID, int64])
+
+	id0 := ids.GenerateTestID()
+	id1 := ids.GenerateTestID()
+	id2 := ids.GenerateTestID()
+	id3 := ids.GenerateTestID()
+
+	cache.Put(id0, 0)
+	cache.Put(id1, 1)
+	cache.Put(id2, 2)
+	cache.Put(id3, 3)
+
+	// Mark [id0] as recently used
+	_, ok := cache.Get(id0)
+	require.True(ok)
+
+	var (
+		keys   []ids.ID
+		values []int64
+	)
+	cache.ForEach(func(key ids.ID, value int64) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+		return true
+	})
+	require.Equal([]ids.ID{id1, id2, id3, id0}, keys)
+	require.Equal([]int64{1, 2, 3, 0}, values)
+
+	// Returning false should stop the iteration
+	keys = nil
+	cache.ForEach(func(key ids.ID, _ int64) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	require.Equal([]ids.ID{id1, id2}, keys)
+
+	// Iterating should not change the recency order
+	cache.Put(ids.GenerateTestID(), 4)
+	_, ok = cache.Get(id1)
+	require.False(ok)
+}
+
+func TestSizedLRUPin(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedLRU[ids.ID, int64](4*cachetest.IntSize, cachetest.IntSizeFunc).(*SizedLRU[ids.ID, int64])
+
+	pinnedID := ids.GenerateTestID()
+	cache.Put(pinnedID, 0)
+	cache.Pin(pinnedID)
+
+	// The pinned element should survive even though it is never used again
+	for i := int64(1); i <= 100; i++ {
+		cache.Put(ids.GenerateTestID(), i)
+	}
+	require.Equal(4, cache.Len())
+	require.InDelta(1, cache.PortionFilled(), 0)
+
+	value, ok := cache.Get(pinnedID)
+	require.True(ok)
+	require.Equal(int64(0), value)
+
+	// Shrinking the cache should only evict unpinned elements
+	cache.Resize(cachetest.IntSize)
+	require.Equal(1, cache.Len())
+	_, ok = cache.Get(pinnedID)
+	require.True(ok)
+
+	// Once unpinned, the element can be evicted again
+	cache.Resize(2 * cachetest.IntSize)
+	cache.Unpin(pinnedID)
+	cache.Put(ids.GenerateTestID(), 1)
+	cache.Put(ids.GenerateTestID(), 2)
+	_, ok = cache.Get(pinnedID)
+	require.False(ok)
+
+	// Pinning a key that isn't in the cache has no effect
+	missingID := ids.GenerateTestID()
+	cache.Pin(missingID)
+	cache.Put(missingID, 3)
+	cache.Put(ids.GenerateTestID(), 4)
+	cache.Put(ids.GenerateTestID(), 5)
+	_, ok = cache.Get(missingID)
+	require.False(ok)
+}
+
+func TestSizedLRUOverPinned(t *testing.T) {
+	require := require.New(t)
+
+	cache := NewSizedLRU[ids.ID, int64](2*cachetest.IntSize, cachetest.IntSizeFunc).(*SizedLRU[ids.ID, int64])
+
+	id0 := ids.GenerateTestID()
+	id1 := ids.GenerateTestID()
+	cache.Put(id0, 0)
+	cache.Put(id1, 1)
+	cache.Pin(id0)
+	cache.Pin(id1)
+
+	// Updating a pinned element should keep it pinned
+	cache.Put(id1, 2)
+	require.Equal(2, cache.Len())
+
+	// With no room left besides the pinned elements, putting a new element
+	// flushes the cache
+	id2 := ids.GenerateTestID()
+	cache.Put(id2, 3)
+	require.Zero(cache.Len())
+	require.Zero(cache.PortionFilled())
+
+	_, ok := cache.Get(id0)
+	require.False(ok)
+
+	// The pins are cleared by the flush
+	cache.Put(id0, 0)
+	cache.Put(id1, 1)
+	cache.Put(id2, 2)
+	require.Equal(2, cache.Len())
+	_, ok = cache.Get(id0)
+	require.False(ok)
+}
+
+func TestSizedLRUMetrics(t *testing.T) {
+	require := require.New(t)
+
+	reg := prometheus.NewRegistry()
+	metrics, err := NewSizedLRUMetrics("", reg)
+	require.NoError(err)
+
+	cache := NewSizedLRU[ids.ID, int64](
+		2*cachetest.IntSize,
+		cachetest.IntSizeFunc,
+		WithMetrics(metrics),
+	)
+
+	requireMetrics := func(hits, misses, evictions, size int) {
+		require.NoError(testutil.GatherAndCompare(reg, strings.NewReader(fmt.Sprintf(`
+# HELP evictions number of entries removed to honor the maximum size
+# TYPE evictions counter
+evictions %d
+# HELP hits number of get calls that found the key
+# TYPE hits counter
+hits %d
+# HELP misses number of get calls that didn't find the key
+# TYPE misses counter
+misses %d
+# HELP size total size of the entries
+# TYPE size gauge
+size %d
+`, evictions, hits, misses, size))))
+	}
+
+	id0 := ids.GenerateTestID()
+	id1 := ids.GenerateTestID()
+	id2 := ids.GenerateTestID()
+
+	cache.Put(id0, 0)
+	cache.Put(id1, 1)
+	requireMetrics(0, 0, 0, 2*cachetest.IntSize)
+
+	_, ok := cache.Get(id0)
+	require.True(ok)
+	_, ok = cache.Get(id2)
+	require.False(ok)
+	requireMetrics(1, 1, 0, 2*cachetest.IntSize)
+
+	// [id1] is the least recently used entry, so it is evicted.
+	cache.Put(id2, 2)
+	_, ok = cache.Get(id1)
+	require.False(ok)
+	requireMetrics(1, 2, 1, 2*cachetest.IntSize)
+
+	// Explicitly evicting an entry isn't counted as an eviction.
+	cache.Evict(id0)
+	requireMetrics(1, 2, 1, cachetest.IntSize)
+
+	cache.Flush()
+	requireMetrics(1, 2, 1, 0)
+}
@@ -1282,6 +1282,14 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	nodeConfig.UseCurrentHeight = v.GetBool(ProposerVMUseCurrentHeightKey)
 
+	// PlatformVM
+	nodeConfig.GasPriceFloor = feecomponent.GasPrice(v.GetUint64(PlatformVMGasPriceFloorKey))
+	nodeConfig.MaxTxsPerBlock = int(v.GetUint(PlatformVMMaxTxsPerBlockKey))
+	nodeConfig.NearGasCapacityThreshold = v.GetFloat64(PlatformVMNearGasCapacityThresholdKey)
+	if nodeConfig.NearGasCapacityThreshold < 0 || nodeConfig.NearGasCapacityThreshold > 1 {
+		return node.Config{}, fmt.Errorf("%s must be in [0,1]", PlatformVMNearGasCapacityThresholdKey)
+	}
+
 	// Logging
 	nodeConfig.LoggingConfig, err = getLoggingConfig(v)
 	if err != nil {
@@ -1392,6 +1400,9 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	// Tx Fee
 	nodeConfig.TxFeeConfig = getTxFeeConfig(v, nodeConfig.NetworkID)
+	if err := nodeConfig.TxFeeConfig.DynamicFeeConfig.Verify(); err != nil {
+		return node.Config{}, fmt.Errorf("invalid dynamic fee config: %w", err)
+	}
 
 	// Genesis Data
 	genesisStakingCfg := nodeConfig.StakingConfig.StakingConfig
@@ -319,6 +319,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted P-chain block height")
 
+	// PlatformVM
+	fs.Uint64(PlatformVMGasPriceFloorKey, 0, "Minimum gas price, after the E-upgrade, of P-chain transactions added to the mempool. If 0, the gas price isn't restricted")
+	fs.Uint(PlatformVMMaxTxsPerBlockKey, 0, "Maximum number of transactions in a P-chain standard block built by this node. If 0, the number of transactions isn't restricted")
+	fs.Float64(PlatformVMNearGasCapacityThresholdKey, 0, "Fraction of the maximum gas capacity above which the gas consumed by an accepted P-chain block is reported as near capacity. If 0, blocks aren't reported")
+
 	// Metrics
 	fs.Bool(MeterVMsEnabledKey, true, "Enable Meter VMs to track VM performance with more granularity")
 	fs.Duration(UptimeMetricFreqKey, 30*time.Second, "Frequency of renewing this node's average uptime metric")
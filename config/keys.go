@@ -156,6 +156,9 @@ const (
 	ConsensusShutdownTimeoutKey                        = "consensus-shutdown-timeout"
 	ConsensusFrontierPollFrequencyKey                  = "consensus-frontier-poll-frequency"
 	ProposerVMUseCurrentHeightKey                      = "proposervm-use-current-height"
+	PlatformVMGasPriceFloorKey                         = "platformvm-gas-price-floor"
+	PlatformVMMaxTxsPerBlockKey                        = "platformvm-max-txs-per-block"
+	PlatformVMNearGasCapacityThresholdKey              = "platformvm-near-gas-capacity-threshold"
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
@@ -24,23 +24,59 @@ type creator struct {
 	InboundMsgBuilder
 }
 
+// CreatorConfig configures the messages built by a Creator.
+type CreatorConfig struct {
+	// CompressionType is the compression applied to outbound messages.
+	CompressionType compression.Type
+	// MaxMessageTimeout is the max deadline of inbound messages.
+	MaxMessageTimeout time.Duration
+	// ZstdDictionary is the precomputed dictionary that zstd compressed
+	// messages are compressed and decompressed with. Peers must use the same
+	// dictionary to be able to decompress each other's messages. If empty, no
+	// dictionary is used.
+	ZstdDictionary []byte
+	// MinCompressionSize is the uncompressed size, in bytes, below which
+	// outbound messages are never compressed. If non-positive, every message
+	// is compressed with [CompressionType].
+	MinCompressionSize int
+}
+
 func NewCreator(
 	log logging.Logger,
 	metrics prometheus.Registerer,
 	compressionType compression.Type,
 	maxMessageTimeout time.Duration,
 ) (Creator, error) {
-	builder, err := newMsgBuilder(
+	return NewCreatorWithConfig(
+		log,
+		metrics,
+		CreatorConfig{
+			CompressionType:   compressionType,
+			MaxMessageTimeout: maxMessageTimeout,
+		},
+	)
+}
+
+// NewCreatorWithConfig returns a Creator that builds messages as configured by
+// [config].
+func NewCreatorWithConfig(
+	log logging.Logger,
+	metrics prometheus.Registerer,
+	config CreatorConfig,
+) (Creator, error) {
+	builder, err := newMsgBuilderWithZstdDictionary(
 		log,
 		metrics,
-		maxMessageTimeout,
+		config.MaxMessageTimeout,
+		config.ZstdDictionary,
+		config.MinCompressionSize,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &creator{
-		OutboundMsgBuilder: newOutboundBuilder(compressionType, builder),
+		OutboundMsgBuilder: newOutboundBuilder(config.CompressionType, builder),
 		InboundMsgBuilder:  newInboundBuilder(builder),
 	}, nil
 }
@@ -144,6 +144,9 @@ type msgBuilder struct {
 	duration       *prometheus.GaugeVec   // type + op + direction
 
 	maxMessageTimeout time.Duration
+	// Outbound messages smaller than [minCompressionSize] bytes are sent
+	// uncompressed.
+	minCompressionSize int
 }
 
 func newMsgBuilder(
@@ -151,7 +154,20 @@ func newMsgBuilder(
 	metrics prometheus.Registerer,
 	maxMessageTimeout time.Duration,
 ) (*msgBuilder, error) {
-	zstdCompressor, err := compression.NewZstdCompressor(constants.DefaultMaxMessageSize)
+	return newMsgBuilderWithZstdDictionary(log, metrics, maxMessageTimeout, nil, 0)
+}
+
+func newMsgBuilderWithZstdDictionary(
+	log logging.Logger,
+	metrics prometheus.Registerer,
+	maxMessageTimeout time.Duration,
+	zstdDictionary []byte,
+	minCompressionSize int,
+) (*msgBuilder, error) {
+	zstdCompressor, err := compression.NewZstdCompressorWithDictionary(
+		constants.DefaultMaxMessageSize,
+		zstdDictionary,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +191,8 @@ func newMsgBuilder(
 			metricLabels,
 		),
 
-		maxMessageTimeout: maxMessageTimeout,
+		maxMessageTimeout:  maxMessageTimeout,
+		minCompressionSize: minCompressionSize,
 	}
 	return mb, errors.Join(
 		metrics.Register(mb.count),
@@ -197,6 +214,11 @@ func (mb *msgBuilder) marshal(
 		return nil, 0, 0, err
 	}
 
+	// Messages below the compression threshold are sent uncompressed.
+	if compressionType == compression.TypeZstd && len(uncompressedMsgBytes) < mb.minCompressionSize {
+		compressionType = compression.TypeNone
+	}
+
 	// If compression is enabled, we marshal twice:
 	// 1. the original message
 	// 2. the message with compressed bytes
@@ -6,6 +6,7 @@ package peer
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 
@@ -204,6 +205,9 @@ type blockingMessageQueue struct {
 
 	// queue of the messages
 	queue chan message.OutboundMessage
+
+	// numBlocked is the number of pushes that found the queue full
+	numBlocked atomic.Uint64
 }
 
 func NewBlockingMessageQueue(
@@ -211,6 +215,14 @@ func NewBlockingMessageQueue(
 	log logging.Logger,
 	bufferSize int,
 ) MessageQueue {
+	return newBlockingMessageQueue(onFailed, log, bufferSize)
+}
+
+func newBlockingMessageQueue(
+	onFailed SendFailedCallback,
+	log logging.Logger,
+	bufferSize int,
+) *blockingMessageQueue {
 	return &blockingMessageQueue{
 		onFailed: onFailed,
 		log:      log,
@@ -245,6 +257,13 @@ func (q *blockingMessageQueue) Push(ctx context.Context, msg message.OutboundMes
 	default:
 	}
 
+	select {
+	case q.queue <- msg:
+		return true
+	default:
+		q.numBlocked.Add(1)
+	}
+
 	select {
 	case q.queue <- msg:
 		return true
@@ -285,6 +304,22 @@ func (q *blockingMessageQueue) PopNow() (message.OutboundMessage, bool) {
 	}
 }
 
+// Len returns the number of messages currently in the queue.
+func (q *blockingMessageQueue) Len() int {
+	return len(q.queue)
+}
+
+// Cap returns the number of messages the queue can hold before Push blocks.
+func (q *blockingMessageQueue) Cap() int {
+	return cap(q.queue)
+}
+
+// NumBlocked returns the number of calls to Push that found the queue full and
+// had to wait for space.
+func (q *blockingMessageQueue) NumBlocked() uint64 {
+	return q.numBlocked.Load()
+}
+
 func (q *blockingMessageQueue) Close() {
 	q.closeOnce.Do(func() {
 		close(q.closing)
@@ -69,6 +69,11 @@ type Peer interface {
 	// LastReceived returns the last time a message was received from the peer.
 	LastReceived() time.Time
 
+	// LatencyEstimate returns the round trip time between the most recently
+	// sent Ping and the Pong received in response. If no Pong has been
+	// received, -1 is returned.
+	LatencyEstimate() time.Duration
+
 	// Ready returns true if the peer has finished the p2p handshake and is
 	// ready to send and receive messages.
 	Ready() bool
@@ -193,6 +198,15 @@ type peer struct {
 	// Must only be accessed atomically
 	lastSent, lastReceived int64
 
+	// Unix time, in nanoseconds, of the last Ping sent
+	// Must only be accessed atomically
+	lastPingSent int64
+
+	// Round trip time, in nanoseconds, of the last Ping, or -1 if no Pong has
+	// been received
+	// Must only be accessed atomically
+	latency int64
+
 	// getPeerListChan signals that we should attempt to send a GetPeerList to
 	// this peer
 	getPeerListChan chan struct{}
@@ -222,6 +236,7 @@ func Start(
 		onClosingCtxCancel: onClosingCtxCancel,
 		onClosed:           make(chan struct{}),
 		observedUptimes:    make(map[ids.ID]uint32),
+		latency:            -1,
 		getPeerListChan:    make(chan struct{}, 1),
 	}
 
@@ -254,6 +269,10 @@ func (p *peer) LastReceived() time.Time {
 	)
 }
 
+func (p *peer) LatencyEstimate() time.Duration {
+	return time.Duration(atomic.LoadInt64(&p.latency))
+}
+
 func (p *peer) Ready() bool {
 	return p.finishedHandshake.Get()
 }
@@ -627,6 +646,9 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 
 	now := p.Clock.Time()
 	p.storeLastSent(now)
+	if msg.Op() == message.PingOp {
+		atomic.StoreInt64(&p.lastPingSent, now.UnixNano())
+	}
 	p.Metrics.Sent(msg)
 }
 
@@ -877,7 +899,21 @@ func (p *peer) getUptimes() (uint32, []*p2p.SubnetUptime) {
 	return primaryUptimePercent, subnetUptimes
 }
 
-func (*peer) handlePong(*p2p.Pong) {}
+func (p *peer) handlePong(*p2p.Pong) {
+	lastPingSent := atomic.LoadInt64(&p.lastPingSent)
+	if lastPingSent == 0 {
+		// We never sent a Ping, so this Pong can't be used to measure the
+		// latency.
+		return
+	}
+
+	latency := p.Clock.Time().UnixNano() - lastPingSent
+	if latency < 0 {
+		// The clock moved backwards since the Ping was sent.
+		return
+	}
+	atomic.StoreInt64(&p.latency, latency)
+}
 
 // Record that the given peer perceives our uptime for the given [subnetID]
 // to be [uptime].
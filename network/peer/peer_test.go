@@ -6,6 +6,7 @@ package peer
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"net"
 	"net/netip"
 	"testing"
@@ -41,6 +42,7 @@ type testPeer struct {
 
 type rawTestPeer struct {
 	config         *Config
+	tlsCert        *tls.Certificate
 	cert           *staking.Certificate
 	nodeID         ids.NodeID
 	inboundMsgChan <-chan message.InboundMessage
@@ -125,6 +127,7 @@ func newRawTestPeer(t *testing.T, config Config) *rawTestPeer {
 
 	return &rawTestPeer{
 		config:         &config,
+		tlsCert:        tlsCert,
 		cert:           cert,
 		nodeID:         nodeID,
 		inboundMsgChan: inboundMsgChan,
@@ -211,6 +214,36 @@ func TestSend(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(context.Background()))
 }
 
+func TestLatencyEstimate(t *testing.T) {
+	require := require.New(t)
+
+	sharedConfig := newConfig(t)
+
+	rawPeer0 := newRawTestPeer(t, sharedConfig)
+	rawPeer1 := newRawTestPeer(t, sharedConfig)
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	require.Equal(time.Duration(-1), peer0.LatencyEstimate())
+
+	pingMsg, err := sharedConfig.MessageCreator.Ping(0, nil)
+	require.NoError(err)
+	require.True(peer0.Send(context.Background(), pingMsg))
+
+	require.Eventually(
+		func() bool {
+			return peer0.LatencyEstimate() >= 0
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+
+	peer1.StartClose()
+	require.NoError(peer0.AwaitClosed(context.Background()))
+	require.NoError(peer1.AwaitClosed(context.Background()))
+}
+
 func TestPingUptimes(t *testing.T) {
 	trackedSubnetID := ids.GenerateTestID()
 	untrackedSubnetID := ids.GenerateTestID()
@@ -6,6 +6,7 @@ package peer
 import (
 	"context"
 	"crypto"
+	"crypto/tls"
 	"net"
 	"net/netip"
 	"time"
@@ -33,10 +34,115 @@ import (
 
 const maxMessageToSend = 1024
 
+// TestPeerOption overrides the default configuration of a peer created by
+// [StartTestPeer] or [StartUnreadyTestPeer].
+type TestPeerOption func(*testPeerOptions)
+
+type testPeerOptions struct {
+	pingFrequency      time.Duration
+	pongTimeout        time.Duration
+	zstdDictionary     []byte
+	minCompressionSize int
+	tlsCert            *tls.Certificate
+}
+
+func newTestPeerOptions(opts []TestPeerOption) *testPeerOptions {
+	o := &testPeerOptions{
+		pingFrequency: constants.DefaultPingFrequency,
+		pongTimeout:   constants.DefaultPingPongTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithPingFrequency sets how often the peer sends pings.
+func WithPingFrequency(pingFrequency time.Duration) TestPeerOption {
+	return func(o *testPeerOptions) {
+		o.pingFrequency = pingFrequency
+	}
+}
+
+// WithPongTimeout sets how long the peer waits to read a message before
+// closing the connection.
+func WithPongTimeout(pongTimeout time.Duration) TestPeerOption {
+	return func(o *testPeerOptions) {
+		o.pongTimeout = pongTimeout
+	}
+}
+
+// WithZstdDictionary sets the dictionary used to compress and decompress zstd
+// compressed messages. By default, no dictionary is used.
+func WithZstdDictionary(zstdDictionary []byte) TestPeerOption {
+	return func(o *testPeerOptions) {
+		o.zstdDictionary = zstdDictionary
+	}
+}
+
+// WithMinCompressionSize sets the size, in bytes, below which messages created
+// by the peer's message creator are sent uncompressed. By default, every
+// message that supports compression is compressed.
+func WithMinCompressionSize(minCompressionSize int) TestPeerOption {
+	return func(o *testPeerOptions) {
+		o.minCompressionSize = minCompressionSize
+	}
+}
+
+// WithTLSCert sets the certificate the peer uses to connect. This allows the
+// peer's NodeID to be known, with [NodeIDFromTLSCert], before connecting. By
+// default, a new certificate is generated.
+func WithTLSCert(tlsCert *tls.Certificate) TestPeerOption {
+	return func(o *testPeerOptions) {
+		o.tlsCert = tlsCert
+	}
+}
+
+// TestPeer is a Peer created by [StartTestPeer] or [StartUnreadyTestPeer]. It
+// exposes its outbound message queue so that tests can observe backpressure.
+type TestPeer struct {
+	Peer
+
+	localID        ids.NodeID
+	queue          *blockingMessageQueue
+	messageCreator message.Creator
+}
+
+// LocalID returns the NodeID that the remote node assigned to this peer.
+func (p *TestPeer) LocalID() ids.NodeID {
+	return p.localID
+}
+
+// MessageCreator returns the message creator the peer was configured with.
+// Messages created with it are compressed the same way as the peer's own
+// messages.
+func (p *TestPeer) MessageCreator() message.Creator {
+	return p.messageCreator
+}
+
+// QueueLen returns the number of outbound messages that have been sent but not
+// yet written to the connection.
+func (p *TestPeer) QueueLen() int {
+	return p.queue.Len()
+}
+
+// QueueCap returns the number of outbound messages that can be queued before
+// [Peer.Send] blocks.
+func (p *TestPeer) QueueCap() int {
+	return p.queue.Cap()
+}
+
+// NumBlockedSends returns the number of calls to [Peer.Send] that found the
+// outbound queue full and had to wait for space.
+func (p *TestPeer) NumBlockedSends() uint64 {
+	return p.queue.NumBlocked()
+}
+
 // StartTestPeer provides a simple interface to create a peer that has finished
 // the p2p handshake.
 //
-// This function will generate a new TLS key to use when connecting to the peer.
+// This function will generate a new TLS key to use when connecting to the peer,
+// unless one is provided with [WithTLSCert].
 //
 // The returned peer will not throttle inbound or outbound messages.
 //
@@ -47,23 +153,51 @@ const maxMessageToSend = 1024
 //     will be returned.
 //   - [router] will be called with all non-handshake messages received by the
 //     peer.
+//   - [opts] override the default ping frequency, pong timeout, zstd
+//     dictionary, compression threshold, and TLS certificate.
 func StartTestPeer(
 	ctx context.Context,
 	ip netip.AddrPort,
 	networkID uint32,
 	router router.InboundHandler,
-) (Peer, error) {
-	dialer := net.Dialer{}
-	conn, err := dialer.DialContext(ctx, constants.NetworkType, ip.String())
+	opts ...TestPeerOption,
+) (*TestPeer, error) {
+	peer, err := StartUnreadyTestPeer(ctx, ip, networkID, router, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return peer, peer.AwaitReady(ctx)
+}
 
-	tlsCert, err := staking.NewTLSCert()
+// StartUnreadyTestPeer is the same as [StartTestPeer] except that it returns
+// the peer as soon as the connection has been established, without waiting
+// for the p2p handshake to finish.
+//
+// The caller is responsible for either waiting for the handshake to finish
+// with [Peer.AwaitReady] or closing the peer with [Peer.StartClose].
+func StartUnreadyTestPeer(
+	ctx context.Context,
+	ip netip.AddrPort,
+	networkID uint32,
+	router router.InboundHandler,
+	opts ...TestPeerOption,
+) (*TestPeer, error) {
+	options := newTestPeerOptions(opts)
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, constants.NetworkType, ip.String())
 	if err != nil {
 		return nil, err
 	}
 
+	tlsCert := options.tlsCert
+	if tlsCert == nil {
+		tlsCert, err = staking.NewTLSCert()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tlsConfg := TLSConfig(*tlsCert, nil)
 	clientUpgrader := NewTLSClientUpgrader(
 		tlsConfg,
@@ -75,11 +209,15 @@ func StartTestPeer(
 		return nil, err
 	}
 
-	mc, err := message.NewCreator(
+	mc, err := message.NewCreatorWithConfig(
 		logging.NoLog{},
 		prometheus.NewRegistry(),
-		constants.DefaultNetworkCompressionType,
-		10*time.Second,
+		message.CreatorConfig{
+			CompressionType:    constants.DefaultNetworkCompressionType,
+			MaxMessageTimeout:  10 * time.Second,
+			ZstdDictionary:     options.zstdDictionary,
+			MinCompressionSize: options.minCompressionSize,
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -106,6 +244,11 @@ func StartTestPeer(
 		return nil, err
 	}
 
+	queue := newBlockingMessageQueue(
+		metrics,
+		logging.NoLog{},
+		maxMessageToSend,
+	)
 	peer := Start(
 		&Config{
 			Metrics:              metrics,
@@ -119,8 +262,8 @@ func StartTestPeer(
 			Beacons:              validators.NewManager(),
 			Validators:           validators.NewManager(),
 			NetworkID:            networkID,
-			PingFrequency:        constants.DefaultPingFrequency,
-			PongTimeout:          constants.DefaultPingPongTimeout,
+			PingFrequency:        options.pingFrequency,
+			PongTimeout:          options.pongTimeout,
 			MaxClockDifference:   time.Minute,
 			ResourceTracker:      resourceTracker,
 			UptimeCalculator:     uptime.NoOpCalculator,
@@ -136,11 +279,12 @@ func StartTestPeer(
 		conn,
 		cert,
 		peerID,
-		NewBlockingMessageQueue(
-			metrics,
-			logging.NoLog{},
-			maxMessageToSend,
-		),
+		queue,
 	)
-	return peer, peer.AwaitReady(ctx)
+	return &TestPeer{
+		Peer:           peer,
+		localID:        NodeIDFromTLSCert(tlsCert),
+		queue:          queue,
+		messageCreator: mc,
+	}, nil
 }
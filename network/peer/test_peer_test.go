@@ -0,0 +1,450 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/message"
+	"github.com/CaiJiJi/avalanchego/proto/pb/p2p"
+	"github.com/CaiJiJi/avalanchego/snow/networking/router"
+	"github.com/CaiJiJi/avalanchego/staking"
+	"github.com/CaiJiJi/avalanchego/utils"
+	"github.com/CaiJiJi/avalanchego/utils/compression"
+	"github.com/CaiJiJi/avalanchego/utils/constants"
+	"github.com/CaiJiJi/avalanchego/utils/logging"
+	"github.com/CaiJiJi/avalanchego/utils/units"
+	"github.com/CaiJiJi/avalanchego/utils/wrappers"
+)
+
+// listenTestPeer accepts a single connection on a new loopback listener and
+// runs the p2p handshake over it using [config].
+func listenTestPeer(t *testing.T, config Config) netip.AddrPort {
+	t.Helper()
+	require := require.New(t)
+
+	listener, err := net.Listen(constants.NetworkType, "127.0.0.1:0")
+	require.NoError(err)
+
+	// The handshake is signed by the key of [rawPeer], so the TLS connection
+	// must be established with its certificate.
+	rawPeer := newRawTestPeer(t, config)
+	serverUpgrader := NewTLSServerUpgrader(
+		TLSConfig(*rawPeer.tlsCert, nil),
+		prometheus.NewCounter(prometheus.CounterOpts{}),
+	)
+
+	peers := make(chan Peer, 1)
+	go func() {
+		defer close(peers)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		peerID, conn, cert, err := serverUpgrader.Upgrade(conn)
+		if err != nil {
+			return
+		}
+		peers <- Start(
+			rawPeer.config,
+			conn,
+			cert,
+			peerID,
+			NewBlockingMessageQueue(
+				rawPeer.config.Metrics,
+				logging.NoLog{},
+				maxMessageToSend,
+			),
+		)
+	}()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		for peer := range peers {
+			peer.StartClose()
+			_ = peer.AwaitClosed(context.Background())
+		}
+	})
+
+	return netip.MustParseAddrPort(listener.Addr().String())
+}
+
+// listenStalledPeer accepts a single connection on a new loopback listener and
+// completes the TLS handshake, but never reads from the connection.
+func listenStalledPeer(t *testing.T) netip.AddrPort {
+	t.Helper()
+	require := require.New(t)
+
+	listener, err := net.Listen(constants.NetworkType, "127.0.0.1:0")
+	require.NoError(err)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	serverUpgrader := NewTLSServerUpgrader(
+		TLSConfig(*tlsCert, nil),
+		prometheus.NewCounter(prometheus.CounterOpts{}),
+	)
+
+	conns := make(chan net.Conn, 1)
+	go func() {
+		defer close(conns)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_, conn, _, err = serverUpgrader.Upgrade(conn)
+		if err != nil {
+			return
+		}
+		conns <- conn
+	}()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		for conn := range conns {
+			_ = conn.Close()
+		}
+	})
+
+	return netip.MustParseAddrPort(listener.Addr().String())
+}
+
+func TestStartUnreadyTestPeer(t *testing.T) {
+	tests := []struct {
+		name        string
+		networkID   uint32
+		expectedErr error
+	}{
+		{
+			name:        "matching network",
+			networkID:   constants.LocalID,
+			expectedErr: nil,
+		},
+		{
+			name:        "mismatched network",
+			networkID:   constants.LocalID + 1,
+			expectedErr: errClosed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ip := listenTestPeer(t, newConfig(t))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			peer, err := StartUnreadyTestPeer(
+				ctx,
+				ip,
+				test.networkID,
+				router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {}),
+			)
+			require.NoError(err)
+
+			err = peer.AwaitReady(ctx)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedErr == nil, peer.Ready())
+
+			peer.StartClose()
+			require.NoError(peer.AwaitClosed(ctx))
+		})
+	}
+}
+
+func TestTestPeerQueueBackpressure(t *testing.T) {
+	require := require.New(t)
+
+	ip := listenStalledPeer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	peer, err := StartUnreadyTestPeer(
+		ctx,
+		ip,
+		constants.LocalID,
+		router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {}),
+	)
+	require.NoError(err)
+	defer func() {
+		peer.StartClose()
+		require.NoError(peer.AwaitClosed(ctx))
+	}()
+
+	require.Equal(maxMessageToSend, peer.QueueCap())
+	require.Zero(peer.NumBlockedSends())
+
+	// The remote never reads, so once the connection's buffers are full every
+	// sent message stays in the queue.
+	msg, err := newMessageCreator(t).AppGossip(
+		ids.GenerateTestID(),
+		utils.RandomBytes(256*units.KiB),
+	)
+	require.NoError(err)
+
+	// A send can only find the queue full once the queue's capacity worth of
+	// messages has been sent. The writer may keep draining the queue until the
+	// connection's buffers are full, so the exact queue depth isn't asserted.
+	numSent := 0
+	for peer.NumBlockedSends() == 0 {
+		require.Less(numSent, 10*maxMessageToSend)
+
+		sendCtx, sendCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		if peer.Send(sendCtx, msg) {
+			numSent++
+		}
+		sendCancel()
+
+		require.LessOrEqual(peer.QueueLen(), maxMessageToSend)
+	}
+	require.GreaterOrEqual(numSent, maxMessageToSend)
+}
+
+func TestTestPeerPongTimeout(t *testing.T) {
+	require := require.New(t)
+
+	const pongTimeout = 250 * time.Millisecond
+
+	// The remote never responds, so the peer should disconnect once it hasn't
+	// read anything for [pongTimeout].
+	ip := listenStalledPeer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	peer, err := StartUnreadyTestPeer(
+		ctx,
+		ip,
+		constants.LocalID,
+		router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {}),
+		WithPingFrequency(pongTimeout/2),
+		WithPongTimeout(pongTimeout),
+	)
+	require.NoError(err)
+
+	closeCtx, closeCancel := context.WithTimeout(ctx, 20*pongTimeout)
+	defer closeCancel()
+
+	err = peer.AwaitReady(closeCtx)
+	require.ErrorIs(err, errClosed)
+	require.NoError(peer.AwaitClosed(closeCtx))
+	require.False(peer.Ready())
+	require.GreaterOrEqual(time.Since(start), pongTimeout)
+}
+
+func TestStartTestPeerNodeID(t *testing.T) {
+	require := require.New(t)
+
+	listener, err := net.Listen(constants.NetworkType, "127.0.0.1:0")
+	require.NoError(err)
+	defer listener.Close()
+
+	serverCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	serverUpgrader := NewTLSServerUpgrader(
+		TLSConfig(*serverCert, nil),
+		prometheus.NewCounter(prometheus.CounterOpts{}),
+	)
+
+	// The remote only records the NodeID it assigns to the test peer.
+	remoteIDs := make(chan ids.NodeID, 1)
+	go func() {
+		defer close(remoteIDs)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		peerID, _, _, err := serverUpgrader.Upgrade(conn)
+		_ = conn.Close()
+		if err != nil {
+			return
+		}
+		remoteIDs <- peerID
+	}()
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	expectedNodeID := NodeIDFromTLSCert(tlsCert)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	peer, err := StartUnreadyTestPeer(
+		ctx,
+		netip.MustParseAddrPort(listener.Addr().String()),
+		constants.LocalID,
+		router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {}),
+		WithTLSCert(tlsCert),
+	)
+	require.NoError(err)
+	defer func() {
+		peer.StartClose()
+		require.NoError(peer.AwaitClosed(ctx))
+	}()
+
+	require.Equal(expectedNodeID, <-remoteIDs)
+	require.Equal(expectedNodeID, peer.LocalID())
+	require.Equal(NodeIDFromTLSCert(serverCert), peer.ID())
+}
+
+// listenAppGossipPeer accepts a single connection on a new loopback listener
+// and completes the TLS handshake. For every AppGossip message read from the
+// connection, it reports whether the message was compressed on the wire. The
+// connection is read until the test peer closes it.
+func listenAppGossipPeer(t *testing.T) (netip.AddrPort, <-chan bool) {
+	t.Helper()
+	require := require.New(t)
+
+	listener, err := net.Listen(constants.NetworkType, "127.0.0.1:0")
+	require.NoError(err)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	serverUpgrader := NewTLSServerUpgrader(
+		TLSConfig(*tlsCert, nil),
+		prometheus.NewCounter(prometheus.CounterOpts{}),
+	)
+
+	zstdCompressor, err := compression.NewZstdCompressor(constants.DefaultMaxMessageSize)
+	require.NoError(err)
+
+	compressed := make(chan bool, 2)
+	go func() {
+		defer close(compressed)
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, conn, _, err = serverUpgrader.Upgrade(conn)
+		if err != nil {
+			return
+		}
+
+		msgLenBytes := make([]byte, wrappers.IntLen)
+		for {
+			if _, err := io.ReadFull(conn, msgLenBytes); err != nil {
+				return
+			}
+			msgLen, err := readMsgLen(msgLenBytes, constants.DefaultMaxMessageSize)
+			if err != nil {
+				return
+			}
+			msgBytes := make([]byte, msgLen)
+			if _, err := io.ReadFull(conn, msgBytes); err != nil {
+				return
+			}
+
+			msg := &p2p.Message{}
+			if err := proto.Unmarshal(msgBytes, msg); err != nil {
+				return
+			}
+			isCompressed := len(msg.GetCompressedZstd()) > 0
+			if isCompressed {
+				msgBytes, err = zstdCompressor.Decompress(msg.GetCompressedZstd())
+				if err != nil {
+					return
+				}
+				if err := proto.Unmarshal(msgBytes, msg); err != nil {
+					return
+				}
+			}
+			if msg.GetAppGossip() != nil {
+				compressed <- isCompressed
+			}
+		}
+	}()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return netip.MustParseAddrPort(listener.Addr().String()), compressed
+}
+
+func TestTestPeerMinCompressionSize(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	appGossipSize := func(appGossipBytes []byte) int {
+		return proto.Size(&p2p.Message{
+			Message: &p2p.Message_AppGossip{
+				AppGossip: &p2p.AppGossip{
+					ChainId:  chainID[:],
+					AppBytes: appGossipBytes,
+				},
+			},
+		})
+	}
+
+	var (
+		smallMsgBytes = make([]byte, units.KiB)
+		largeMsgBytes = make([]byte, units.KiB+1)
+	)
+	// The small message is one byte below the threshold and the large message
+	// is exactly at it.
+	minCompressionSize := appGossipSize(largeMsgBytes)
+	require.Equal(minCompressionSize-1, appGossipSize(smallMsgBytes))
+
+	ip, compressed := listenAppGossipPeer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	peer, err := StartUnreadyTestPeer(
+		ctx,
+		ip,
+		constants.LocalID,
+		router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {}),
+		WithMinCompressionSize(minCompressionSize),
+	)
+	require.NoError(err)
+	defer func() {
+		peer.StartClose()
+		require.NoError(peer.AwaitClosed(ctx))
+	}()
+
+	for _, test := range []struct {
+		appGossipBytes     []byte
+		expectedCompressed bool
+	}{
+		{
+			appGossipBytes:     smallMsgBytes,
+			expectedCompressed: false,
+		},
+		{
+			appGossipBytes:     largeMsgBytes,
+			expectedCompressed: true,
+		},
+	} {
+		msg, err := peer.MessageCreator().AppGossip(chainID, test.appGossipBytes)
+		require.NoError(err)
+		require.True(peer.Send(ctx, msg))
+
+		select {
+		case isCompressed := <-compressed:
+			require.Equal(test.expectedCompressed, isCompressed)
+		case <-ctx.Done():
+			require.FailNow("timed out waiting for AppGossip message")
+		}
+	}
+}
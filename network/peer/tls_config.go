@@ -6,6 +6,9 @@ package peer
 import (
 	"crypto/tls"
 	"io"
+
+	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/staking"
 )
 
 // TLSConfig returns the TLS config that will allow secure connections to other
@@ -28,3 +31,13 @@ func TLSConfig(cert tls.Certificate, keyLogWriter io.Writer) *tls.Config {
 		KeyLogWriter:       keyLogWriter,
 	}
 }
+
+// NodeIDFromTLSCert returns the NodeID that peers will assign to a node that
+// connects to them using [cert].
+//
+// [cert] must contain at least one certificate.
+func NodeIDFromTLSCert(cert *tls.Certificate) ids.NodeID {
+	return ids.NodeIDFromCert(&staking.Certificate{
+		Raw: cert.Certificate[0],
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/profiler"
 	"github.com/CaiJiJi/avalanchego/utils/set"
 	"github.com/CaiJiJi/avalanchego/utils/timer"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 )
 
 type APIIndexerConfig struct {
@@ -208,6 +209,15 @@ type Config struct {
 	// See comment on [UseCurrentHeight] in platformvm.Config
 	UseCurrentHeight bool `json:"useCurrentHeight"`
 
+	// See comment on [GasPriceFloor] in platformvm.Config
+	GasPriceFloor fee.GasPrice `json:"gasPriceFloor"`
+
+	// See comment on [MaxTxsPerBlock] in platformvm.Config
+	MaxTxsPerBlock int `json:"maxTxsPerBlock"`
+
+	// See comment on [NearGasCapacityThreshold] in platformvm.Config
+	NearGasCapacityThreshold float64 `json:"nearGasCapacityThreshold"`
+
 	// ProvidedFlags contains all the flags set by the user
 	ProvidedFlags map[string]interface{} `json:"-"`
 
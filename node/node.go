@@ -1228,6 +1228,9 @@ func (n *Node) initVMs() error {
 				CreateAssetTxFee:          n.Config.CreateAssetTxFee,
 				StaticFeeConfig:           n.Config.StaticFeeConfig,
 				DynamicFeeConfig:          n.Config.DynamicFeeConfig,
+				GasPriceFloor:             n.Config.GasPriceFloor,
+				MaxTxsPerBlock:            n.Config.MaxTxsPerBlock,
+				NearGasCapacityThreshold:  n.Config.NearGasCapacityThreshold,
 				UptimePercentage:          n.Config.UptimeRequirement,
 				MinValidatorStake:         n.Config.MinValidatorStake,
 				MaxValidatorStake:         n.Config.MaxValidatorStake,
@@ -1242,9 +1245,10 @@ func (n *Node) initVMs() error {
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
 			Config: avmconfig.Config{
-				Upgrades:         n.Config.UpgradeConfig,
-				TxFee:            n.Config.StaticFeeConfig.TxFee,
-				CreateAssetTxFee: n.Config.CreateAssetTxFee,
+				Upgrades:          n.Config.UpgradeConfig,
+				TxFee:             n.Config.StaticFeeConfig.TxFee,
+				CreateAssetTxFee:  n.Config.CreateAssetTxFee,
+				OptimalProcessing: n.Config.SubnetConfigs[constants.PrimaryNetworkID].ConsensusParameters.OptimalProcessing,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.EVMID, &coreth.Factory{}),
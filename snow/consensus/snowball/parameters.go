@@ -4,9 +4,12 @@
 package snowball
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/CaiJiJi/avalanchego/api/health"
 )
 
 const (
@@ -17,6 +20,23 @@ const (
 	// 1 means MinPercentConnected = 1 (fully connected).
 	MinPercentConnectedBuffer = .2
 
+	// MinHealthyAlphaPreferenceRatio is the AlphaPreference/K ratio below
+	// which parameters are reported as degraded. Verify only requires the
+	// ratio to exceed 1/2, but a ratio close to 1/2 lets a small number of
+	// faulty or unresponsive nodes swing preferences.
+	MinHealthyAlphaPreferenceRatio = .6
+
+	// MaxHealthyMinPercentConnected is the MinPercentConnectedHealthy value
+	// above which parameters are reported as degraded, as the node would need
+	// to be connected to nearly all stake to be considered healthy.
+	MaxHealthyMinPercentConnected = .95
+
+	// Names of the preset parameters that can be looked up with
+	// ParametersByProfile.
+	DefaultProfile = "default"
+	FastProfile    = "fast"
+	SafeProfile    = "safe"
+
 	errMsg = `__________                    .___
 \______   \____________     __| _/__.__.
  |    |  _/\_  __ \__  \   / __ <   |  |
@@ -35,6 +55,8 @@ const (
 )
 
 var (
+	_ health.Checker = Parameters{}
+
 	DefaultParameters = Parameters{
 		K:                     20,
 		AlphaPreference:       15,
@@ -46,9 +68,55 @@ var (
 		MaxItemProcessingTime: 30 * time.Second,
 	}
 
+	// FastParameters finalize decisions in fewer rounds than
+	// DefaultParameters by requiring fewer consecutive successful polls. This
+	// lowers latency at the cost of a higher probability of a safety failure.
+	FastParameters = Parameters{
+		K:                     20,
+		AlphaPreference:       15,
+		AlphaConfidence:       15,
+		Beta:                  12,
+		ConcurrentRepolls:     4,
+		OptimalProcessing:     10,
+		MaxOutstandingItems:   256,
+		MaxItemProcessingTime: 30 * time.Second,
+	}
+
+	// SafeParameters require larger supermajorities and more consecutive
+	// successful polls than DefaultParameters before finalizing a decision.
+	// This lowers the probability of a safety failure at the cost of higher
+	// latency and lower tolerance of offline or unresponsive validators.
+	SafeParameters = Parameters{
+		K:                     20,
+		AlphaPreference:       15,
+		AlphaConfidence:       18,
+		Beta:                  30,
+		ConcurrentRepolls:     4,
+		OptimalProcessing:     10,
+		MaxOutstandingItems:   256,
+		MaxItemProcessingTime: 30 * time.Second,
+	}
+
+	profiles = map[string]Parameters{
+		DefaultProfile: DefaultParameters,
+		FastProfile:    FastParameters,
+		SafeProfile:    SafeParameters,
+	}
+
 	ErrParametersInvalid = errors.New("parameters invalid")
+	ErrUnknownProfile    = errors.New("unknown parameters profile")
 )
 
+// ParametersByProfile returns the preset parameters with the provided profile
+// [name].
+func ParametersByProfile(name string) (Parameters, error) {
+	p, ok := profiles[name]
+	if !ok {
+		return Parameters{}, fmt.Errorf("%w: %q", ErrUnknownProfile, name)
+	}
+	return p, nil
+}
+
 // Parameters required for snowball consensus
 type Parameters struct {
 	// K is the number of nodes to query and sample in a round.
@@ -78,6 +146,19 @@ type Parameters struct {
 	MaxItemProcessingTime time.Duration `json:"maxItemProcessingTime" yaml:"maxItemProcessingTime"`
 }
 
+// PartialParameters overrides a subset of Parameters. Fields that are nil are
+// left unchanged by Merge.
+type PartialParameters struct {
+	K                     *int           `json:"k,omitempty" yaml:"k,omitempty"`
+	AlphaPreference       *int           `json:"alphaPreference,omitempty" yaml:"alphaPreference,omitempty"`
+	AlphaConfidence       *int           `json:"alphaConfidence,omitempty" yaml:"alphaConfidence,omitempty"`
+	Beta                  *int           `json:"beta,omitempty" yaml:"beta,omitempty"`
+	ConcurrentRepolls     *int           `json:"concurrentRepolls,omitempty" yaml:"concurrentRepolls,omitempty"`
+	OptimalProcessing     *int           `json:"optimalProcessing,omitempty" yaml:"optimalProcessing,omitempty"`
+	MaxOutstandingItems   *int           `json:"maxOutstandingItems,omitempty" yaml:"maxOutstandingItems,omitempty"`
+	MaxItemProcessingTime *time.Duration `json:"maxItemProcessingTime,omitempty" yaml:"maxItemProcessingTime,omitempty"`
+}
+
 // Verify returns nil if the parameters describe a valid initialization.
 //
 // An initialization is valid if the following conditions are met:
@@ -115,6 +196,73 @@ func (p Parameters) Verify() error {
 	}
 }
 
+// ExpectedFinalizationRounds returns an optimistic lower bound on the number
+// of polls required to finalize a decision, which is Beta.
+//
+// The bound assumes that every poll is answered by K nodes and that every
+// poll reaches AlphaConfidence for the same choice, so that confidence is
+// never reset. Under contention or with unresponsive nodes, finalization
+// takes more polls. [p] is assumed to have passed Verify.
+func (p Parameters) ExpectedFinalizationRounds() int {
+	return p.Beta
+}
+
+// Diff returns the fields, keyed by their json names, whose values differ
+// between [p] and [other]. Each entry holds the value in [p] followed by the
+// value in [other].
+//
+// Alpha is compared by the value it points to, and is reported as nil when it
+// isn't set.
+func (p Parameters) Diff(other Parameters) map[string][2]any {
+	diff := make(map[string][2]any)
+	add := func(name string, oldValue, newValue any) {
+		if oldValue != newValue {
+			diff[name] = [2]any{oldValue, newValue}
+		}
+	}
+	add("k", p.K, other.K)
+	add("alpha", alphaValue(p.Alpha), alphaValue(other.Alpha))
+	add("alphaPreference", p.AlphaPreference, other.AlphaPreference)
+	add("alphaConfidence", p.AlphaConfidence, other.AlphaConfidence)
+	add("beta", p.Beta, other.Beta)
+	add("concurrentRepolls", p.ConcurrentRepolls, other.ConcurrentRepolls)
+	add("optimalProcessing", p.OptimalProcessing, other.OptimalProcessing)
+	add("maxOutstandingItems", p.MaxOutstandingItems, other.MaxOutstandingItems)
+	add("maxItemProcessingTime", p.MaxItemProcessingTime, other.MaxItemProcessingTime)
+	return diff
+}
+
+// Merge returns [p] with the fields set in [override] replaced by their
+// overridden values. An error is returned if the result fails Verify.
+func (p Parameters) Merge(override PartialParameters) (Parameters, error) {
+	set := func(field *int, value *int) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	set(&p.K, override.K)
+	set(&p.AlphaPreference, override.AlphaPreference)
+	set(&p.AlphaConfidence, override.AlphaConfidence)
+	set(&p.Beta, override.Beta)
+	set(&p.ConcurrentRepolls, override.ConcurrentRepolls)
+	set(&p.OptimalProcessing, override.OptimalProcessing)
+	set(&p.MaxOutstandingItems, override.MaxOutstandingItems)
+	if override.MaxItemProcessingTime != nil {
+		p.MaxItemProcessingTime = *override.MaxItemProcessingTime
+	}
+	if err := p.Verify(); err != nil {
+		return Parameters{}, err
+	}
+	return p, nil
+}
+
+func alphaValue(alpha *int) any {
+	if alpha == nil {
+		return nil
+	}
+	return *alpha
+}
+
 func (p Parameters) MinPercentConnectedHealthy() float64 {
 	// AlphaConfidence is used here to ensure that the node can still feasibly
 	// accept operations. If AlphaPreference were used, committing could be
@@ -123,6 +271,99 @@ func (p Parameters) MinPercentConnectedHealthy() float64 {
 	return alphaRatio*(1-MinPercentConnectedBuffer) + MinPercentConnectedBuffer
 }
 
+// ProcessingHealth reports whether an engine with [numOutstanding] processing
+// items, the oldest of which has been processing for [longestProcessing], is
+// within the MaxOutstandingItems and MaxItemProcessingTime bounds.
+//
+// The returned details always include the provided values and the configured
+// bounds. If a bound is breached, the details additionally include an
+// explanation of the breach.
+func (p Parameters) ProcessingHealth(longestProcessing time.Duration, numOutstanding int) (bool, map[string]any) {
+	healthy := true
+	details := map[string]any{
+		"outstandingItems":      numOutstanding,
+		"maxOutstandingItems":   p.MaxOutstandingItems,
+		"longestProcessing":     longestProcessing.String(), // .String() is needed here to ensure a human readable format
+		"maxItemProcessingTime": p.MaxItemProcessingTime.String(),
+	}
+	if numOutstanding > p.MaxOutstandingItems {
+		healthy = false
+		details["tooManyOutstandingItems"] = fmt.Sprintf("%d > %d",
+			numOutstanding,
+			p.MaxOutstandingItems,
+		)
+	}
+	if longestProcessing > p.MaxItemProcessingTime {
+		healthy = false
+		details["itemProcessingTooLong"] = fmt.Sprintf("%s > %s",
+			longestProcessing,
+			p.MaxItemProcessingTime,
+		)
+	}
+	return healthy, details
+}
+
+// ShouldThrottleBuild reports whether block building should be paused because
+// [processing] blocks are already processing, which is at least
+// OptimalProcessing.
+func (p Parameters) ShouldThrottleBuild(processing int) bool {
+	return processing >= p.OptimalProcessing
+}
+
+// BuildPressure returns the ratio of [processing] to OptimalProcessing, clamped
+// to [0, 1]. It can be used to apply softer backpressure to block building
+// than ShouldThrottleBuild. [p] is assumed to have passed Verify.
+func (p Parameters) BuildPressure(processing int) float64 {
+	pressure := float64(processing) / float64(p.OptimalProcessing)
+	return min(max(pressure, 0), 1)
+}
+
+// HealthCheck reports whether [p] is within a risky margin of the bounds
+// enforced by Verify. An error is only returned if [p] fails Verify.
+//
+// Parameters with an AlphaPreference/K ratio below
+// MinHealthyAlphaPreferenceRatio, or with a MinPercentConnectedHealthy above
+// MaxHealthyMinPercentConnected, are valid but are reported as degraded along
+// with advice on how to make them more robust.
+func (p Parameters) HealthCheck(context.Context) (interface{}, error) {
+	if err := p.Verify(); err != nil {
+		return map[string]any{
+			"status": "invalid",
+		}, err
+	}
+
+	alphaPreferenceRatio := float64(p.AlphaPreference) / float64(p.K)
+	minPercentConnected := p.MinPercentConnectedHealthy()
+	details := map[string]any{
+		"status":                     "healthy",
+		"alphaPreferenceRatio":       alphaPreferenceRatio,
+		"minPercentConnectedHealthy": minPercentConnected,
+	}
+
+	var advice []string
+	if alphaPreferenceRatio < MinHealthyAlphaPreferenceRatio {
+		advice = append(advice, fmt.Sprintf(
+			"alphaPreference/k = %d/%d is below %.2f: increase alphaPreference so that a few faulty nodes can't swing preferences",
+			p.AlphaPreference,
+			p.K,
+			MinHealthyAlphaPreferenceRatio,
+		))
+	}
+	if minPercentConnected > MaxHealthyMinPercentConnected {
+		advice = append(advice, fmt.Sprintf(
+			"alphaConfidence/k = %d/%d requires connecting to %.2f%% of stake to be healthy: decrease alphaConfidence so that unresponsive nodes can be tolerated",
+			p.AlphaConfidence,
+			p.K,
+			minPercentConnected*100,
+		))
+	}
+	if len(advice) > 0 {
+		details["status"] = "degraded"
+		details["advice"] = advice
+	}
+	return details, nil
+}
+
 type terminationCondition struct {
 	alphaConfidence int
 	beta            int
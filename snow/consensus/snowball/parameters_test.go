@@ -4,7 +4,9 @@
 package snowball
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -256,3 +258,364 @@ func TestParametersMinPercentConnectedHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestParametersProcessingHealth(t *testing.T) {
+	params := Parameters{
+		MaxOutstandingItems:   10,
+		MaxItemProcessingTime: 10 * time.Second,
+	}
+
+	tests := []struct {
+		name                 string
+		longestProcessing    time.Duration
+		numOutstanding       int
+		expectedHealthy      bool
+		expectedBreachedKeys []string
+	}{
+		{
+			name:              "nothing processing",
+			longestProcessing: 0,
+			numOutstanding:    0,
+			expectedHealthy:   true,
+		},
+		{
+			name:              "at both bounds",
+			longestProcessing: 10 * time.Second,
+			numOutstanding:    10,
+			expectedHealthy:   true,
+		},
+		{
+			name:                 "too many outstanding items",
+			longestProcessing:    10 * time.Second,
+			numOutstanding:       11,
+			expectedHealthy:      false,
+			expectedBreachedKeys: []string{"tooManyOutstandingItems"},
+		},
+		{
+			name:                 "processing too long",
+			longestProcessing:    10*time.Second + time.Nanosecond,
+			numOutstanding:       10,
+			expectedHealthy:      false,
+			expectedBreachedKeys: []string{"itemProcessingTooLong"},
+		},
+		{
+			name:                 "both bounds breached",
+			longestProcessing:    time.Minute,
+			numOutstanding:       100,
+			expectedHealthy:      false,
+			expectedBreachedKeys: []string{"tooManyOutstandingItems", "itemProcessingTooLong"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			healthy, details := params.ProcessingHealth(test.longestProcessing, test.numOutstanding)
+			require.Equal(test.expectedHealthy, healthy)
+			require.Equal(test.numOutstanding, details["outstandingItems"])
+			require.Equal(params.MaxOutstandingItems, details["maxOutstandingItems"])
+			require.Equal(test.longestProcessing.String(), details["longestProcessing"])
+			require.Equal(params.MaxItemProcessingTime.String(), details["maxItemProcessingTime"])
+			require.Len(details, 4+len(test.expectedBreachedKeys))
+			for _, key := range test.expectedBreachedKeys {
+				require.Contains(details, key)
+			}
+		})
+	}
+}
+
+func TestParametersBuildThrottling(t *testing.T) {
+	tests := []struct {
+		name                  string
+		processing            int
+		expectedThrottle      bool
+		expectedBuildPressure float64
+	}{
+		{
+			name:                  "none processing",
+			processing:            0,
+			expectedThrottle:      false,
+			expectedBuildPressure: 0,
+		},
+		{
+			name:                  "below optimal",
+			processing:            DefaultParameters.OptimalProcessing / 2,
+			expectedThrottle:      false,
+			expectedBuildPressure: .5,
+		},
+		{
+			name:                  "at optimal",
+			processing:            DefaultParameters.OptimalProcessing,
+			expectedThrottle:      true,
+			expectedBuildPressure: 1,
+		},
+		{
+			name:                  "above optimal",
+			processing:            2 * DefaultParameters.OptimalProcessing,
+			expectedThrottle:      true,
+			expectedBuildPressure: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Equal(test.expectedThrottle, DefaultParameters.ShouldThrottleBuild(test.processing))
+			require.Equal(test.expectedBuildPressure, DefaultParameters.BuildPressure(test.processing))
+		})
+	}
+}
+
+func TestParametersHealthCheck(t *testing.T) {
+	withAlphas := func(alphaPreference, alphaConfidence int) Parameters {
+		p := DefaultParameters
+		p.AlphaPreference = alphaPreference
+		p.AlphaConfidence = alphaConfidence
+		return p
+	}
+
+	tests := []struct {
+		name           string
+		params         Parameters
+		expectedStatus string
+		expectedAdvice int
+		expectedErr    error
+	}{
+		{
+			name:           "default",
+			params:         DefaultParameters,
+			expectedStatus: "healthy",
+		},
+		{
+			name:           "safe",
+			params:         SafeParameters,
+			expectedStatus: "healthy",
+		},
+		{
+			name:           "barely a majority",
+			params:         withAlphas(DefaultParameters.K/2+1, DefaultParameters.K/2+1),
+			expectedStatus: "degraded",
+			expectedAdvice: 1,
+		},
+		{
+			name:           "alphaConfidence equals k",
+			params:         withAlphas(DefaultParameters.AlphaPreference, DefaultParameters.K),
+			expectedStatus: "degraded",
+			expectedAdvice: 1,
+		},
+		{
+			name:           "barely a majority and alphaConfidence equals k",
+			params:         withAlphas(DefaultParameters.K/2+1, DefaultParameters.K),
+			expectedStatus: "degraded",
+			expectedAdvice: 2,
+		},
+		{
+			name:           "invalid",
+			params:         withAlphas(DefaultParameters.K/2, DefaultParameters.K/2),
+			expectedStatus: "invalid",
+			expectedErr:    ErrParametersInvalid,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			detailsIntf, err := test.params.HealthCheck(context.Background())
+			require.ErrorIs(err, test.expectedErr)
+			require.IsType(map[string]any{}, detailsIntf)
+
+			details := detailsIntf.(map[string]any)
+			require.Equal(test.expectedStatus, details["status"])
+			if test.expectedAdvice == 0 {
+				require.NotContains(details, "advice")
+				return
+			}
+			require.Len(details["advice"], test.expectedAdvice)
+		})
+	}
+}
+
+func TestParametersByProfile(t *testing.T) {
+	tests := []struct {
+		name               string
+		expectedParameters Parameters
+		expectedErr        error
+	}{
+		{
+			name:               DefaultProfile,
+			expectedParameters: DefaultParameters,
+			expectedErr:        nil,
+		},
+		{
+			name:               FastProfile,
+			expectedParameters: FastParameters,
+			expectedErr:        nil,
+		},
+		{
+			name:               SafeProfile,
+			expectedParameters: SafeParameters,
+			expectedErr:        nil,
+		},
+		{
+			name:               "unknown",
+			expectedParameters: Parameters{},
+			expectedErr:        ErrUnknownProfile,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			p, err := ParametersByProfile(test.name)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedParameters, p)
+			if test.expectedErr == nil {
+				require.NoError(p.Verify())
+			}
+		})
+	}
+}
+
+func TestProfilesVerify(t *testing.T) {
+	for name, p := range profiles {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, p.Verify())
+		})
+	}
+}
+
+func TestParametersExpectedFinalizationRounds(t *testing.T) {
+	for name, p := range profiles {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			require.NoError(p.Verify())
+			require.Equal(p.Beta, p.ExpectedFinalizationRounds())
+		})
+	}
+}
+
+func TestParametersDiff(t *testing.T) {
+	alpha15 := 15
+	otherAlpha15 := 15
+	alpha16 := 16
+
+	tests := []struct {
+		name     string
+		old      Parameters
+		new      Parameters
+		expected map[string][2]any
+	}{
+		{
+			name:     "identical",
+			old:      DefaultParameters,
+			new:      DefaultParameters,
+			expected: map[string][2]any{},
+		},
+		{
+			name: "changed k",
+			old:  DefaultParameters,
+			new: func() Parameters {
+				p := DefaultParameters
+				p.K = 21
+				return p
+			}(),
+			expected: map[string][2]any{
+				"k": {20, 21},
+			},
+		},
+		{
+			name: "changed profile",
+			old:  DefaultParameters,
+			new:  SafeParameters,
+			expected: map[string][2]any{
+				"alphaConfidence": {15, 18},
+				"beta":            {20, 30},
+			},
+		},
+		{
+			name: "equal alpha values behind different pointers",
+			old: Parameters{
+				Alpha: &alpha15,
+			},
+			new: Parameters{
+				Alpha: &otherAlpha15,
+			},
+			expected: map[string][2]any{},
+		},
+		{
+			name: "changed alpha",
+			old: Parameters{
+				Alpha: &alpha15,
+			},
+			new: Parameters{
+				Alpha: &alpha16,
+			},
+			expected: map[string][2]any{
+				"alpha": {15, 16},
+			},
+		},
+		{
+			name: "set alpha",
+			old:  Parameters{},
+			new: Parameters{
+				Alpha: &alpha15,
+			},
+			expected: map[string][2]any{
+				"alpha": {nil, 15},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.old.Diff(test.new))
+		})
+	}
+}
+
+func TestParametersMerge(t *testing.T) {
+	beta30 := 30
+	beta1 := 1
+
+	tests := []struct {
+		name        string
+		override    PartialParameters
+		expected    Parameters
+		expectedErr error
+	}{
+		{
+			name:     "empty override",
+			expected: DefaultParameters,
+		},
+		{
+			name: "override beta",
+			override: PartialParameters{
+				Beta: &beta30,
+			},
+			expected: func() Parameters {
+				p := DefaultParameters
+				p.Beta = 30
+				return p
+			}(),
+		},
+		{
+			name: "invalid result",
+			override: PartialParameters{
+				Beta: &beta1,
+			},
+			expectedErr: ErrParametersInvalid,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			merged, err := DefaultParameters.Merge(test.override)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, merged)
+			if test.expectedErr == nil {
+				require.NoError(merged.Verify())
+			}
+		})
+	}
+}
@@ -647,7 +647,7 @@ func (e *Engine) sendChits(ctx context.Context, nodeID ids.NodeID, requestID uin
 // Build blocks if they have been requested and the number of processing blocks
 // is less than optimal.
 func (e *Engine) buildBlocks(ctx context.Context) error {
-	for e.pendingBuildBlocks > 0 && e.Consensus.NumProcessing() < e.Params.OptimalProcessing {
+	for e.pendingBuildBlocks > 0 && !e.Params.ShouldThrottleBuild(e.Consensus.NumProcessing()) {
 		e.pendingBuildBlocks--
 
 		blk, err := e.VM.BuildBlock(ctx)
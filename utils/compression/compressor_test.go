@@ -4,6 +4,7 @@
 package compression
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"runtime"
@@ -107,6 +108,26 @@ func TestCompressDecompress(t *testing.T) {
 	}
 }
 
+func TestZstdCompressorWithDictionary(t *testing.T) {
+	require := require.New(t)
+
+	dictionary := newSimilarMessages(4)
+	compressor, err := NewZstdCompressorWithDictionary(maxMessageSize, bytes.Join(dictionary, nil))
+	require.NoError(err)
+
+	for _, msg := range newSimilarMessages(16) {
+		compressed, err := compressor.Compress(msg)
+		require.NoError(err)
+
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(err)
+		require.Equal(msg, decompressed)
+	}
+
+	_, err = compressor.Compress(utils.RandomBytes(maxMessageSize + 1))
+	require.ErrorIs(err, ErrMsgTooLarge)
+}
+
 func TestSizeLimiting(t *testing.T) {
 	for compressionType, compressorFunc := range newCompressorFuncs {
 		if compressionType == TypeNone {
@@ -243,3 +264,59 @@ func BenchmarkDecompress(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkZstdDictionaryCompressedSize reports the average compressed size of
+// a batch of similar messages, with and without a dictionary.
+func BenchmarkZstdDictionaryCompressedSize(b *testing.B) {
+	const numMessages = 64
+
+	msgs := newSimilarMessages(numMessages)
+	dictionary := bytes.Join(newSimilarMessages(8), nil)
+	tests := []struct {
+		name       string
+		dictionary []byte
+	}{
+		{
+			name: "without_dictionary",
+		},
+		{
+			name:       "with_dictionary",
+			dictionary: dictionary,
+		},
+	}
+	for _, test := range tests {
+		b.Run(test.name, func(b *testing.B) {
+			require := require.New(b)
+
+			compressor, err := NewZstdCompressorWithDictionary(maxMessageSize, test.dictionary)
+			require.NoError(err)
+
+			var compressedSize int
+			for n := 0; n < b.N; n++ {
+				compressedSize = 0
+				for _, msg := range msgs {
+					compressed, err := compressor.Compress(msg)
+					require.NoError(err)
+					compressedSize += len(compressed)
+				}
+			}
+			b.ReportMetric(float64(compressedSize)/numMessages, "bytes/msg")
+		})
+	}
+}
+
+// newSimilarMessages returns [n] messages that share most of their structure,
+// but differ in their IDs and values.
+func newSimilarMessages(n int) [][]byte {
+	msgs := make([][]byte, n)
+	for i := range msgs {
+		msgs[i] = []byte(fmt.Sprintf(
+			`{"chainID":"2oYMBNV4eNHyqk2fjjV5nVQLDbtmNJzq5s3qs3Lo6ftnC6FByM","requestID":%d,"deadline":%d,"containerIDs":["%x","%x"],"engineType":"ENGINE_TYPE_SNOWMAN"}`,
+			i,
+			1_000_000+i,
+			utils.RandomBytes(32),
+			utils.RandomBytes(32),
+		))
+	}
+	return msgs
+}
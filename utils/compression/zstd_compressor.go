@@ -22,6 +22,15 @@ var (
 )
 
 func NewZstdCompressor(maxSize int64) (Compressor, error) {
+	return NewZstdCompressorWithDictionary(maxSize, nil)
+}
+
+// NewZstdCompressorWithDictionary returns a zstd compressor that compresses and
+// decompresses messages using the precomputed [dictionary]. Messages compressed
+// with a dictionary can only be decompressed with the same dictionary.
+//
+// If [dictionary] is empty, no dictionary is used.
+func NewZstdCompressorWithDictionary(maxSize int64, dictionary []byte) (Compressor, error) {
 	if maxSize == math.MaxInt64 {
 		// "Decompress" creates "io.LimitReader" with max size + 1:
 		// if the max size + 1 overflows, "io.LimitReader" reads nothing
@@ -30,24 +39,42 @@ func NewZstdCompressor(maxSize int64) (Compressor, error) {
 		return nil, ErrInvalidMaxSizeCompressor
 	}
 
+	if len(dictionary) == 0 {
+		dictionary = nil
+	}
 	return &zstdCompressor{
-		maxSize: maxSize,
+		maxSize:    maxSize,
+		dictionary: dictionary,
 	}, nil
 }
 
 type zstdCompressor struct {
-	maxSize int64
+	maxSize    int64
+	dictionary []byte
 }
 
 func (z *zstdCompressor) Compress(msg []byte) ([]byte, error) {
 	if int64(len(msg)) > z.maxSize {
 		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), z.maxSize)
 	}
-	return zstd.Compress(nil, msg)
+	if z.dictionary == nil {
+		return zstd.Compress(nil, msg)
+	}
+
+	var compressed bytes.Buffer
+	writer := zstd.NewWriterLevelDict(&compressed, zstd.DefaultCompression, z.dictionary)
+	if _, err := writer.Write(msg); err != nil {
+		_ = writer.Close()
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
 }
 
 func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
-	reader := zstd.NewReader(bytes.NewReader(msg))
+	reader := zstd.NewReaderDict(bytes.NewReader(msg), z.dictionary)
 	defer reader.Close()
 
 	// We allow [io.LimitReader] to read up to [z.maxSize + 1] bytes, so that if
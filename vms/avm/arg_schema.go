@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// JSONSchema describes the JSON encoding of an API argument.
+type JSONSchema struct {
+	Type       string                 `json:"type"`
+	Properties map[string]*JSONSchema `json:"properties,omitempty"`
+	Items      *JSONSchema            `json:"items,omitempty"`
+}
+
+// newJSONSchema returns the schema of the JSON encoding of [t].
+//
+// Types that provide their own JSON or text marshalling, such as IDs and
+// avajson integers, are always encoded as strings by this package, so they are
+// described as strings.
+func newJSONSchema(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if implementsMarshaler(t) {
+		return &JSONSchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are encoded as base64 strings.
+			return &JSONSchema{Type: "string"}
+		}
+		return &JSONSchema{
+			Type:  "array",
+			Items: newJSONSchema(t.Elem()),
+		}
+	case reflect.Map:
+		return &JSONSchema{Type: "object"}
+	case reflect.Struct:
+		schema := &JSONSchema{
+			Type:       "object",
+			Properties: make(map[string]*JSONSchema),
+		}
+		addStructProperties(schema, t)
+		return schema
+	default:
+		return &JSONSchema{}
+	}
+}
+
+// addStructProperties adds the JSON encoded fields of the struct [t] to the
+// properties of [schema]. The fields of embedded structs are promoted, as they
+// are by encoding/json.
+func addStructProperties(schema *JSONSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			addStructProperties(schema, fieldType)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = newJSONSchema(field.Type)
+	}
+}
+
+func implementsMarshaler(t reflect.Type) bool {
+	ptr := reflect.PointerTo(t)
+	return t.Implements(jsonMarshalerType) ||
+		ptr.Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) ||
+		ptr.Implements(textMarshalerType)
+}
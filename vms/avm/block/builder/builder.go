@@ -21,8 +21,8 @@ import (
 	txexecutor "github.com/CaiJiJi/avalanchego/vms/avm/txs/executor"
 )
 
-// targetBlockSize is the max block size we aim to produce
-const targetBlockSize = 128 * units.KiB
+// TargetBlockSize is the max block size we aim to produce
+const TargetBlockSize = 128 * units.KiB
 
 var (
 	_ Builder = (*builder)(nil)
@@ -90,14 +90,14 @@ func (b *builder) BuildBlock(context.Context) (snowman.Block, error) {
 	var (
 		blockTxs      []*txs.Tx
 		inputs        set.Set[ids.ID]
-		remainingSize = targetBlockSize
+		remainingSize = TargetBlockSize
 	)
 	for {
 		tx, exists := b.mempool.Peek()
-		// Invariant: [mempool.MaxTxSize] < [targetBlockSize]. This guarantees
+		// Invariant: [mempool.MaxTxSize] < [TargetBlockSize]. This guarantees
 		// that we will only stop building a block once there are no
 		// transactions in the mempool or the block is at least
-		// [targetBlockSize - mempool.MaxTxSize] bytes full.
+		// [TargetBlockSize - mempool.MaxTxSize] bytes full.
 		if !exists || len(tx.Bytes()) > remainingSize {
 			break
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/formatting/address"
 	"github.com/CaiJiJi/avalanchego/utils/json"
 	"github.com/CaiJiJi/avalanchego/utils/rpc"
+	"github.com/CaiJiJi/avalanchego/vms/components/avax"
 )
 
 var (
@@ -33,8 +34,38 @@ type Client interface {
 	GetBlock(ctx context.Context, blkID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
+	// GetBlocksByID returns the blocks with the given IDs, keyed by ID, along
+	// with the requested IDs whose blocks weren't found.
+	GetBlocksByID(ctx context.Context, blkIDs []ids.ID, options ...rpc.Option) (map[ids.ID][]byte, []ids.ID, error)
+	// GetBlockRangeByTime returns the accepted blocks with timestamps in the
+	// inclusive window [startTime, endTime].
+	GetBlockRangeByTime(ctx context.Context, startTime, endTime time.Time, options ...rpc.Option) ([]AcceptedBlock, error)
+	// GetArgSchemas returns the JSON schemas of the arguments of the most common
+	// API methods, keyed by the name of the argument type.
+	GetArgSchemas(ctx context.Context, options ...rpc.Option) (map[string]*JSONSchema, error)
+	// GetFeeAsset returns the description of the asset that is burned to pay
+	// transaction fees.
+	GetFeeAsset(ctx context.Context, options ...rpc.Option) (*GetAssetDescriptionReply, error)
+	// GetFeeConfig returns the fees charged for issuing transactions.
+	GetFeeConfig(ctx context.Context, options ...rpc.Option) (*GetFeeConfigReply, error)
+	// EstimateConfirmationTime returns a coarse estimate of how long a tx
+	// issued now would take to be included in an accepted block.
+	EstimateConfirmationTime(ctx context.Context, options ...rpc.Option) (*EstimateConfirmationTimeReply, error)
+	// GetGenesisAssets returns the assets created at genesis
+	GetGenesisAssets(ctx context.Context, options ...rpc.Option) ([]GenesisAssetDescription, error)
 	// GetHeight returns the height of the last accepted block.
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// IssueTxFromParts issues a transaction to a node after assembling it from
+	// its unsigned bytes and the signatures of each of its credentials.
+	IssueTxFromParts(ctx context.Context, unsignedTxBytes []byte, signatures [][][secp256k1.SignatureLen]byte, options ...rpc.Option) (ids.ID, error)
+	// IssueTxWithRetry issues a transaction to a node, resubmitting it up to
+	// [maxRetries] times, starting after [backoff], if it is rejected for a
+	// transient reason. Zero values use the node's defaults.
+	IssueTxWithRetry(ctx context.Context, txBytes []byte, maxRetries uint32, backoff time.Duration, options ...rpc.Option) (ids.ID, error)
+	// ReplaceTx issues a transaction to a node in place of the pending
+	// transactions that consume any of the same inputs. The transaction must
+	// burn a sufficiently higher fee than the transactions it replaces.
+	ReplaceTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error)
 	// GetTxStatus returns the status of [txID]
 	//
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -42,6 +73,11 @@ type Client interface {
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	// GetTxInputUTXOs returns the UTXOs consumed by the inputs of [txID]
+	GetTxInputUTXOs(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]TxInputUTXO, error)
+	// DecodeTx returns the JSON representation of [txBytes] without issuing
+	// it
+	DecodeTx(ctx context.Context, txBytes []byte, options ...rpc.Option) ([]byte, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -62,8 +98,24 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetUTXO returns the byte representation of the unspent UTXO [utxoID].
+	// If [sourceChain] is not empty, the UTXO is looked up in the shared
+	// memory of [sourceChain].
+	GetUTXO(ctx context.Context, utxoID avax.UTXOID, sourceChain string, options ...rpc.Option) ([]byte, error)
+	// GetTxsSpendingUTXO returns the IDs of the mempool txs that consume
+	// [utxoID]
+	GetTxsSpendingUTXO(ctx context.Context, utxoID avax.UTXOID, options ...rpc.Option) ([]ids.ID, error)
+	// GetTxsByMemo returns up to [pageSize] IDs of accepted txs whose memo
+	// equals [memo], or starts with [memo] if [prefix] is true, starting at
+	// [cursor], along with the cursor of the next page
+	GetTxsByMemo(ctx context.Context, memo string, prefix bool, cursor uint64, pageSize uint64, options ...rpc.Option) ([]ids.ID, uint64, error)
 	// GetAssetDescription returns a description of [assetID]
 	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error)
+	// GetAssetID returns the assets whose symbol or name is [query]
+	GetAssetID(ctx context.Context, query string, options ...rpc.Option) ([]GetAssetDescriptionReply, error)
+	// GetAssets returns up to [pageSize] assets created on the chain, starting
+	// at [cursor], along with the cursor of the next page
+	GetAssets(ctx context.Context, cursor uint64, pageSize uint64, options ...rpc.Option) ([]GetAssetDescriptionReply, uint64, error)
 	// GetBalance returns the balance of [assetID] held by [addr].
 	// If [includePartial], balance includes partial owned (i.e. in a multisig) funds.
 	//
@@ -73,6 +125,20 @@ type Client interface {
 	//
 	// Deprecated: GetUTXOs should be used instead.
 	GetAllBalances(ctx context.Context, addr ids.ShortID, includePartial bool, options ...rpc.Option) ([]Balance, error)
+	// GetBalanceUnlockSchedule returns when the locked balances of [addr]
+	// unlock, per asset
+	GetBalanceUnlockSchedule(ctx context.Context, addr ids.ShortID, includePartial bool, options ...rpc.Option) ([]AssetUnlockSchedule, error)
+	// GetNFTs returns the NFTs held by [addr] in up to [limit] of its UTXOs,
+	// starting after [startAddress] and [startUTXOID], along with the index of
+	// the last UTXO scanned
+	GetNFTs(
+		ctx context.Context,
+		addr ids.ShortID,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([]NFTGroup, ids.ShortID, ids.ID, error)
 	// CreateAsset creates a new asset and returns its assetID
 	//
 	// Deprecated: Transactions should be issued using the
@@ -133,6 +199,16 @@ type Client interface {
 		minters []ClientOwners,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// GetChangeAddress returns the address that would receive the change of a
+	// tx funded by [from] and built from [user]'s keys. If [changeAddr] is
+	// empty, the default change address is returned.
+	GetChangeAddress(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		options ...rpc.Option,
+	) (ids.ShortID, error)
 	// CreateAddress creates a new address controlled by [user]
 	//
 	// Deprecated: Keys should no longer be stored on the node.
@@ -141,10 +217,20 @@ type Client interface {
 	//
 	// Deprecated: Keys should no longer be stored on the node.
 	ListAddresses(ctx context.Context, user api.UserPass, options ...rpc.Option) ([]ids.ShortID, error)
+	// ListAddressesWithBalances returns all addresses on this chain controlled
+	// by [user] along with their AVAX balances, or the balances of all assets
+	// if [allAssets].
+	//
+	// Deprecated: Keys should no longer be stored on the node.
+	ListAddressesWithBalances(ctx context.Context, user api.UserPass, includePartial bool, allAssets bool, options ...rpc.Option) ([]AddressBalances, error)
 	// ExportKey returns the private key corresponding to [addr] controlled by [user]
 	//
 	// Deprecated: Keys should no longer be stored on the node.
 	ExportKey(ctx context.Context, user api.UserPass, addr ids.ShortID, options ...rpc.Option) (*secp256k1.PrivateKey, error)
+	// ExportAllKeys returns every private key controlled by [user]
+	//
+	// Deprecated: Keys should no longer be stored on the node.
+	ExportAllKeys(ctx context.Context, user api.UserPass, options ...rpc.Option) ([]*secp256k1.PrivateKey, error)
 	// ImportKey imports [privateKey] to [user]
 	//
 	// Deprecated: Keys should no longer be stored on the node.
@@ -213,6 +299,41 @@ type Client interface {
 		assetID string,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// EstimateTxSize returns the size, in bytes, of the signed tx that
+	// SendMultiple would issue for the same arguments
+	EstimateTxSize(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		clientOutputs []ClientSendOutput,
+		memo string,
+		options ...rpc.Option,
+	) (uint64, error)
+	// SimulateSend returns the UTXOs that sending [amount] of [assetID] from
+	// [from] to [to] would consume and create, without issuing a tx
+	SimulateSend(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		amount uint64,
+		assetID string,
+		to ids.ShortID,
+		memo string,
+		options ...rpc.Option,
+	) (*SimulateSendReply, error)
+	// SuggestConsolidation returns the small UTXOs of [assetID] held by
+	// [addrs] that are worth consolidating into a single output. UTXOs worth
+	// less than [maxAmount] are considered. [maxAmount] may only be zero for
+	// the fee asset, in which case UTXOs worth less than the tx fee are
+	// considered.
+	SuggestConsolidation(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		assetID string,
+		maxAmount uint64,
+		options ...rpc.Option,
+	) (*SuggestConsolidationReply, error)
 }
 
 // implementation for an AVM client for interacting with avm [chain]
@@ -257,6 +378,93 @@ func (c *client) GetBlockByHeight(ctx context.Context, height uint64, options ..
 	return formatting.Decode(res.Encoding, res.Block)
 }
 
+// formattedBlockByID is the client side representation of BlockByID, where
+// the block is known to be encoded as a string.
+type formattedBlockByID struct {
+	BlockID ids.ID `json:"blockID"`
+	Block   string `json:"block"`
+}
+
+type formattedBlocksByID struct {
+	Blocks   []formattedBlockByID `json:"blocks"`
+	Missing  []ids.ID             `json:"missing"`
+	Encoding formatting.Encoding  `json:"encoding"`
+}
+
+func (c *client) GetBlocksByID(ctx context.Context, blkIDs []ids.ID, options ...rpc.Option) (map[ids.ID][]byte, []ids.ID, error) {
+	res := &formattedBlocksByID{}
+	err := c.requester.SendRequest(ctx, "avm.getBlocksByID", &GetBlocksByIDArgs{
+		BlockIDs: blkIDs,
+		Encoding: formatting.HexNC,
+	}, res, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks := make(map[ids.ID][]byte, len(res.Blocks))
+	for _, blk := range res.Blocks {
+		blkBytes, err := formatting.Decode(res.Encoding, blk.Block)
+		if err != nil {
+			return nil, nil, err
+		}
+		blocks[blk.BlockID] = blkBytes
+	}
+	return blocks, res.Missing, nil
+}
+
+func (c *client) GetBlockRangeByTime(ctx context.Context, startTime, endTime time.Time, options ...rpc.Option) ([]AcceptedBlock, error) {
+	var (
+		blocks []AcceptedBlock
+		cursor json.Uint64
+	)
+	for {
+		res := &GetBlockRangeByTimeReply{}
+		err := c.requester.SendRequest(ctx, "avm.getBlockRangeByTime", &GetBlockRangeByTimeArgs{
+			StartTime: json.Uint64(startTime.Unix()),
+			EndTime:   json.Uint64(endTime.Unix()),
+			Cursor:    cursor,
+		}, res, options...)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, res.Blocks...)
+		if !res.Truncated {
+			return blocks, nil
+		}
+		cursor = res.Cursor
+	}
+}
+
+func (c *client) GetArgSchemas(ctx context.Context, options ...rpc.Option) (map[string]*JSONSchema, error) {
+	res := &ArgSchemasReply{}
+	err := c.requester.SendRequest(ctx, "avm.getArgSchemas", struct{}{}, res, options...)
+	return res.Schemas, err
+}
+
+func (c *client) GetFeeAsset(ctx context.Context, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
+	res := &GetAssetDescriptionReply{}
+	err := c.requester.SendRequest(ctx, "avm.getFeeAsset", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetFeeConfig(ctx context.Context, options ...rpc.Option) (*GetFeeConfigReply, error) {
+	res := &GetFeeConfigReply{}
+	err := c.requester.SendRequest(ctx, "avm.getFeeConfig", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) EstimateConfirmationTime(ctx context.Context, options ...rpc.Option) (*EstimateConfirmationTimeReply, error) {
+	res := &EstimateConfirmationTimeReply{}
+	err := c.requester.SendRequest(ctx, "avm.estimateConfirmationTime", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetGenesisAssets(ctx context.Context, options ...rpc.Option) ([]GenesisAssetDescription, error) {
+	res := &GetGenesisAssetsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getGenesisAssets", struct{}{}, res, options...)
+	return res.Assets, err
+}
+
 func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error) {
 	res := &api.GetHeightResponse{}
 	err := c.requester.SendRequest(ctx, "avm.getHeight", struct{}{}, res, options...)
@@ -276,6 +484,73 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) IssueTxFromParts(
+	ctx context.Context,
+	unsignedTxBytes []byte,
+	signatures [][][secp256k1.SignatureLen]byte,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	unsignedTxStr, err := formatting.Encode(formatting.Hex, unsignedTxBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	sigStrs := make([][]string, len(signatures))
+	for i, credSigs := range signatures {
+		sigStrs[i] = make([]string, len(credSigs))
+		for j, sig := range credSigs {
+			sigStrs[i][j], err = formatting.Encode(formatting.Hex, sig[:])
+			if err != nil {
+				return ids.Empty, err
+			}
+		}
+	}
+	res := &api.JSONTxID{}
+	err = c.requester.SendRequest(ctx, "avm.issueTxFromParts", &IssueTxFromPartsArgs{
+		UnsignedTx: unsignedTxStr,
+		Signatures: sigStrs,
+		Encoding:   formatting.Hex,
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) IssueTxWithRetry(
+	ctx context.Context,
+	txBytes []byte,
+	maxRetries uint32,
+	backoff time.Duration,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	res := &api.JSONTxID{}
+	err = c.requester.SendRequest(ctx, "avm.issueTxWithRetry", &IssueTxWithRetryArgs{
+		FormattedTx: api.FormattedTx{
+			Tx:       txStr,
+			Encoding: formatting.Hex,
+		},
+		MaxRetries: json.Uint32(maxRetries),
+		Backoff:    json.Uint64(backoff.Milliseconds()),
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) ReplaceTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	res := &api.JSONTxID{}
+	err = c.requester.SendRequest(ctx, "avm.replaceTx", &ReplaceTxArgs{
+		FormattedTx: api.FormattedTx{
+			Tx:       txStr,
+			Encoding: formatting.Hex,
+		},
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
 	res := &GetTxStatusReply{}
 	err := c.requester.SendRequest(ctx, "avm.getTxStatus", &api.JSONTxID{
@@ -296,6 +571,27 @@ func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option)
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+func (c *client) GetTxInputUTXOs(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]TxInputUTXO, error) {
+	res := &GetTxInputUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getTxInputUTXOs", &api.JSONTxID{
+		TxID: txID,
+	}, res, options...)
+	return res.UTXOs, err
+}
+
+func (c *client) DecodeTx(ctx context.Context, txBytes []byte, options ...rpc.Option) ([]byte, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	res := &api.GetTxReply{}
+	err = c.requester.SendRequest(ctx, "avm.decodeTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res.Tx, err
+}
+
 func (c *client) GetUTXOs(
 	ctx context.Context,
 	addrs []ids.ShortID,
@@ -347,6 +643,38 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+func (c *client) GetUTXO(ctx context.Context, utxoID avax.UTXOID, sourceChain string, options ...rpc.Option) ([]byte, error) {
+	res := &GetUTXOReply{}
+	err := c.requester.SendRequest(ctx, "avm.getUTXO", &GetUTXOArgs{
+		UTXOID:      utxoID,
+		SourceChain: sourceChain,
+		Encoding:    formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	return formatting.Decode(res.Encoding, res.UTXO)
+}
+
+func (c *client) GetTxsSpendingUTXO(ctx context.Context, utxoID avax.UTXOID, options ...rpc.Option) ([]ids.ID, error) {
+	res := &GetTxsSpendingUTXOReply{}
+	err := c.requester.SendRequest(ctx, "avm.getTxsSpendingUTXO", &GetTxsSpendingUTXOArgs{
+		UTXOID: utxoID,
+	}, res, options...)
+	return res.TxIDs, err
+}
+
+func (c *client) GetTxsByMemo(ctx context.Context, memo string, prefix bool, cursor uint64, pageSize uint64, options ...rpc.Option) ([]ids.ID, uint64, error) {
+	res := &GetAddressTxsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getTxsByMemo", &GetTxsByMemoArgs{
+		Memo:     memo,
+		Prefix:   prefix,
+		Cursor:   json.Uint64(cursor),
+		PageSize: json.Uint64(pageSize),
+	}, res, options...)
+	return res.TxIDs, uint64(res.Cursor), err
+}
+
 func (c *client) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
 	res := &GetAssetDescriptionReply{}
 	err := c.requester.SendRequest(ctx, "avm.getAssetDescription", &GetAssetDescriptionArgs{
@@ -355,6 +683,23 @@ func (c *client) GetAssetDescription(ctx context.Context, assetID string, option
 	return res, err
 }
 
+func (c *client) GetAssetID(ctx context.Context, query string, options ...rpc.Option) ([]GetAssetDescriptionReply, error) {
+	res := &GetAssetIDReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssetID", &GetAssetIDArgs{
+		Query: query,
+	}, res, options...)
+	return res.Assets, err
+}
+
+func (c *client) GetAssets(ctx context.Context, cursor uint64, pageSize uint64, options ...rpc.Option) ([]GetAssetDescriptionReply, uint64, error) {
+	res := &GetAssetsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssets", &GetAssetsArgs{
+		Cursor:   json.Uint64(cursor),
+		PageSize: json.Uint64(pageSize),
+	}, res, options...)
+	return res.Assets, uint64(res.Cursor), err
+}
+
 func (c *client) GetBalance(
 	ctx context.Context,
 	addr ids.ShortID,
@@ -385,6 +730,49 @@ func (c *client) GetAllBalances(
 	return res.Balances, err
 }
 
+func (c *client) GetBalanceUnlockSchedule(
+	ctx context.Context,
+	addr ids.ShortID,
+	includePartial bool,
+	options ...rpc.Option,
+) ([]AssetUnlockSchedule, error) {
+	res := &GetBalanceUnlockScheduleReply{}
+	err := c.requester.SendRequest(ctx, "avm.getBalanceUnlockSchedule", &GetBalanceUnlockScheduleArgs{
+		JSONAddress:    api.JSONAddress{Address: addr.String()},
+		IncludePartial: includePartial,
+	}, res, options...)
+	return res.Assets, err
+}
+
+func (c *client) GetNFTs(
+	ctx context.Context,
+	addr ids.ShortID,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) ([]NFTGroup, ids.ShortID, ids.ID, error) {
+	res := &GetNFTsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getNFTs", &GetNFTsArgs{
+		JSONAddress: api.JSONAddress{Address: addr.String()},
+		Limit:       json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+	}, res, options...)
+	if err != nil {
+		return nil, ids.ShortID{}, ids.Empty, err
+	}
+
+	endAddr, err := address.ParseToID(res.EndIndex.Address)
+	if err != nil {
+		return nil, ids.ShortID{}, ids.Empty, err
+	}
+	endUTXOID, err := ids.FromString(res.EndIndex.UTXO)
+	return res.Groups, endAddr, endUTXOID, err
+}
+
 // ClientHolder describes how much an address owns of an asset
 type ClientHolder struct {
 	Amount  uint64
@@ -536,6 +924,31 @@ func (c *client) CreateNFTAsset(
 	return res.AssetID, err
 }
 
+func (c *client) GetChangeAddress(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	options ...rpc.Option,
+) (ids.ShortID, error) {
+	var changeAddrStr string
+	if changeAddr != ids.ShortEmpty {
+		changeAddrStr = changeAddr.String()
+	}
+	res := &api.JSONChangeAddr{}
+	err := c.requester.SendRequest(ctx, "avm.getChangeAddress", &GetChangeAddressArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+		},
+	}, res, options...)
+	if err != nil {
+		return ids.ShortID{}, err
+	}
+	return address.ParseToID(res.ChangeAddr)
+}
+
 func (c *client) CreateAddress(ctx context.Context, user api.UserPass, options ...rpc.Option) (ids.ShortID, error) {
 	res := &api.JSONAddress{}
 	err := c.requester.SendRequest(ctx, "avm.createAddress", &user, res, options...)
@@ -554,6 +967,16 @@ func (c *client) ListAddresses(ctx context.Context, user api.UserPass, options .
 	return address.ParseToIDs(res.Addresses)
 }
 
+func (c *client) ListAddressesWithBalances(ctx context.Context, user api.UserPass, includePartial bool, allAssets bool, options ...rpc.Option) ([]AddressBalances, error) {
+	res := &ListAddressesWithBalancesReply{}
+	err := c.requester.SendRequest(ctx, "avm.listAddressesWithBalances", &ListAddressesWithBalancesArgs{
+		UserPass:       user,
+		IncludePartial: includePartial,
+		AllAssets:      allAssets,
+	}, res, options...)
+	return res.Addresses, err
+}
+
 func (c *client) ExportKey(ctx context.Context, user api.UserPass, addr ids.ShortID, options ...rpc.Option) (*secp256k1.PrivateKey, error) {
 	res := &ExportKeyReply{}
 	err := c.requester.SendRequest(ctx, "avm.exportKey", &ExportKeyArgs{
@@ -563,6 +986,19 @@ func (c *client) ExportKey(ctx context.Context, user api.UserPass, addr ids.Shor
 	return res.PrivateKey, err
 }
 
+func (c *client) ExportAllKeys(ctx context.Context, user api.UserPass, options ...rpc.Option) ([]*secp256k1.PrivateKey, error) {
+	res := &ExportAllKeysReply{}
+	err := c.requester.SendRequest(ctx, "avm.exportAllKeys", &user, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*secp256k1.PrivateKey, len(res.Keys))
+	for i, key := range res.Keys {
+		keys[i] = key.PrivateKey
+	}
+	return keys, nil
+}
+
 func (c *client) ImportKey(ctx context.Context, user api.UserPass, privateKey *secp256k1.PrivateKey, options ...rpc.Option) (ids.ShortID, error) {
 	res := &api.JSONAddress{}
 	err := c.requester.SendRequest(ctx, "avm.importKey", &ImportKeyArgs{
@@ -633,6 +1069,78 @@ func (c *client) SendMultiple(
 	return res.TxID, err
 }
 
+func (c *client) EstimateTxSize(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	clientOutputs []ClientSendOutput,
+	memo string,
+	options ...rpc.Option,
+) (uint64, error) {
+	res := &EstimateTxSizeReply{}
+	outputs := make([]SendOutput, len(clientOutputs))
+	for i, clientOutput := range clientOutputs {
+		outputs[i] = SendOutput{
+			Amount:  json.Uint64(clientOutput.Amount),
+			AssetID: clientOutput.AssetID,
+			To:      clientOutput.To.String(),
+		}
+	}
+	err := c.requester.SendRequest(ctx, "avm.estimateTxSize", &EstimateTxSizeArgs{
+		SendMultipleArgs: SendMultipleArgs{
+			JSONSpendHeader: api.JSONSpendHeader{
+				UserPass:       user,
+				JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+				JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			},
+			Outputs: outputs,
+			Memo:    memo,
+		},
+	}, res, options...)
+	return uint64(res.Size), err
+}
+
+func (c *client) SimulateSend(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	amount uint64,
+	assetID string,
+	to ids.ShortID,
+	memo string,
+	options ...rpc.Option,
+) (*SimulateSendReply, error) {
+	res := &SimulateSendReply{}
+	err := c.requester.SendRequest(ctx, "avm.simulateSend", &SimulateSendArgs{
+		JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+		JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		SendOutput: SendOutput{
+			Amount:  json.Uint64(amount),
+			AssetID: assetID,
+			To:      to.String(),
+		},
+		Memo: memo,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) SuggestConsolidation(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	assetID string,
+	maxAmount uint64,
+	options ...rpc.Option,
+) (*SuggestConsolidationReply, error) {
+	res := &SuggestConsolidationReply{}
+	err := c.requester.SendRequest(ctx, "avm.suggestConsolidation", &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: ids.ShortIDsToStrings(addrs)},
+		AssetID:       assetID,
+		MaxAmount:     json.Uint64(maxAmount),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) Mint(
 	ctx context.Context,
 	user api.UserPass,
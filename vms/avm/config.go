@@ -5,22 +5,45 @@ package avm
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/CaiJiJi/avalanchego/utils/constants"
 	"github.com/CaiJiJi/avalanchego/vms/avm/network"
 )
 
 var DefaultConfig = Config{
-	Network:              network.DefaultConfig,
-	IndexTransactions:    false,
-	IndexAllowIncomplete: false,
-	ChecksumsEnabled:     false,
+	Network:                       network.DefaultConfig,
+	IndexTransactions:             false,
+	IndexAllowIncomplete:          false,
+	IndexMemos:                    false,
+	ChecksumsEnabled:              false,
+	GetUTXOsDeadline:              0,
+	ReplaceTxMinFeeBumpPercentage: 10,
+	ExportAllKeysEnabled:          false,
+	MaxTxSize:                     constants.DefaultMaxMessageSize,
 }
 
 type Config struct {
 	Network              network.Config `json:"network"`
 	IndexTransactions    bool           `json:"index-transactions"`
 	IndexAllowIncomplete bool           `json:"index-allow-incomplete"`
-	ChecksumsEnabled     bool           `json:"checksums-enabled"`
+	// IndexMemos enables the index of tx memos used by GetTxsByMemo. Only txs
+	// accepted while the index is enabled are indexed.
+	IndexMemos       bool `json:"index-memos"`
+	ChecksumsEnabled bool `json:"checksums-enabled"`
+	// GetUTXOsDeadline is how long a GetUTXOs call may scan before returning
+	// the UTXOs gathered so far. A deadline of 0 never cuts a scan short.
+	GetUTXOsDeadline time.Duration `json:"get-utxos-deadline"`
+	// ReplaceTxMinFeeBumpPercentage is how much higher, as a percentage, the
+	// fee of a tx passed to ReplaceTx must be than the combined fees of the
+	// mempool txs it replaces.
+	ReplaceTxMinFeeBumpPercentage uint64 `json:"replace-tx-min-fee-bump-percentage"`
+	// ExportAllKeysEnabled allows ExportAllKeys to return every private key of
+	// a keystore user.
+	ExportAllKeysEnabled bool `json:"export-all-keys-enabled"`
+	// MaxTxSize is the maximum size, in bytes, of a tx submitted through the
+	// API. Larger txs are rejected before they are parsed.
+	MaxTxSize int `json:"max-tx-size"`
 }
 
 func ParseConfig(configBytes []byte) (Config, error) {
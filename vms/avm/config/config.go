@@ -14,4 +14,9 @@ type Config struct {
 
 	// Fee that must be burned by every asset creating transaction
 	CreateAssetTxFee uint64
+
+	// OptimalProcessing is the consensus parameter of the primary network
+	// that limits the number of blocks processing at once. See
+	// [snowball.Parameters].
+	OptimalProcessing int
 }
@@ -27,10 +27,13 @@ func TestParseConfig(t *testing.T) {
 			name:        "manually specified checksums enabled",
 			configBytes: []byte(`{"checksums-enabled":true}`),
 			expectedConfig: Config{
-				Network:              network.DefaultConfig,
-				IndexTransactions:    DefaultConfig.IndexTransactions,
-				IndexAllowIncomplete: DefaultConfig.IndexAllowIncomplete,
-				ChecksumsEnabled:     true,
+				Network:                       network.DefaultConfig,
+				IndexTransactions:             DefaultConfig.IndexTransactions,
+				IndexAllowIncomplete:          DefaultConfig.IndexAllowIncomplete,
+				ChecksumsEnabled:              true,
+				ReplaceTxMinFeeBumpPercentage: DefaultConfig.ReplaceTxMinFeeBumpPercentage,
+				ExportAllKeysEnabled:          DefaultConfig.ExportAllKeysEnabled,
+				MaxTxSize:                     DefaultConfig.MaxTxSize,
 			},
 		},
 		{
@@ -56,9 +59,12 @@ func TestParseConfig(t *testing.T) {
 					ExpectedBloomFilterFalsePositiveProbability: network.DefaultConfig.ExpectedBloomFilterFalsePositiveProbability,
 					MaxBloomFilterFalsePositiveProbability:      network.DefaultConfig.MaxBloomFilterFalsePositiveProbability,
 				},
-				IndexTransactions:    DefaultConfig.IndexTransactions,
-				IndexAllowIncomplete: DefaultConfig.IndexAllowIncomplete,
-				ChecksumsEnabled:     DefaultConfig.ChecksumsEnabled,
+				IndexTransactions:             DefaultConfig.IndexTransactions,
+				IndexAllowIncomplete:          DefaultConfig.IndexAllowIncomplete,
+				ChecksumsEnabled:              DefaultConfig.ChecksumsEnabled,
+				ReplaceTxMinFeeBumpPercentage: DefaultConfig.ReplaceTxMinFeeBumpPercentage,
+				ExportAllKeysEnabled:          DefaultConfig.ExportAllKeysEnabled,
+				MaxTxSize:                     DefaultConfig.MaxTxSize,
 			},
 		},
 	}
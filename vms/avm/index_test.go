@@ -233,6 +233,66 @@ func TestIndexingAllowIncomplete(t *testing.T) {
 	require.ErrorIs(err, index.ErrIndexingRequiredFromGenesis)
 }
 
+func TestIndexerMetrics(t *testing.T) {
+	require := require.New(t)
+
+	registry := prometheus.NewRegistry()
+	indexer, err := index.NewIndexer(memdb.New(), logging.NoWarn{}, "", registry, false)
+	require.NoError(err)
+
+	var (
+		addrA  = ids.GenerateTestShortID()
+		addrB  = ids.GenerateTestShortID()
+		addrC  = ids.GenerateTestShortID()
+		assetX = avax.Asset{ID: ids.GenerateTestID()}
+		assetY = avax.Asset{ID: ids.GenerateTestID()}
+	)
+	multiAddrUTXO := buildUTXO(avax.UTXOID{TxID: ids.GenerateTestID()}, assetY, addrA)
+	multiAddrUTXO.Out.(*secp256k1fx.TransferOutput).Addrs = []ids.ShortID{addrA, addrC}
+
+	batch := []struct {
+		inputUTXOs  []*avax.UTXO
+		outputUTXOs []*avax.UTXO
+	}{
+		{
+			// (A, X) and (B, X)
+			inputUTXOs:  []*avax.UTXO{buildUTXO(avax.UTXOID{TxID: ids.GenerateTestID()}, assetX, addrA)},
+			outputUTXOs: []*avax.UTXO{buildUTXO(avax.UTXOID{TxID: ids.GenerateTestID()}, assetX, addrB)},
+		},
+		{
+			// (A, Y) and (C, Y)
+			outputUTXOs: []*avax.UTXO{multiAddrUTXO},
+		},
+		{
+			// (A, X) is only written once
+			inputUTXOs:  []*avax.UTXO{buildUTXO(avax.UTXOID{TxID: ids.GenerateTestID()}, assetX, addrA)},
+			outputUTXOs: []*avax.UTXO{buildUTXO(avax.UTXOID{TxID: ids.GenerateTestID()}, assetX, addrA)},
+		},
+	}
+	for _, tx := range batch {
+		require.NoError(indexer.Accept(ids.GenerateTestID(), tx.inputUTXOs, tx.outputUTXOs))
+	}
+
+	metricFamilies, err := registry.Gather()
+	require.NoError(err)
+
+	values := make(map[string]float64)
+	for _, metricFamily := range metricFamilies {
+		for _, metric := range metricFamily.GetMetric() {
+			switch {
+			case metric.GetCounter() != nil:
+				values[metricFamily.GetName()] = metric.GetCounter().GetValue()
+			case metric.GetHistogram() != nil:
+				values[metricFamily.GetName()] = float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	require.Equal(float64(len(batch)), values["txs_indexed"])
+	require.Equal(float64(5), values["address_txs_indexed"])
+	require.Equal(float64(len(batch)), values["address_txs_per_tx"])
+	require.Contains(values, "indexing_time")
+}
+
 func buildUTXO(utxoID avax.UTXOID, txAssetID avax.Asset, addr ids.ShortID) *avax.UTXO {
 	return &avax.UTXO{
 		UTXOID: utxoID,
@@ -307,12 +367,17 @@ func assertIndexedTX(t *testing.T, db database.Database, index uint64, sourceAdd
 //	    - 0: txID1
 //	    - 1: txID1
 func initTestTxIndex(t *testing.T, db *versiondb.Database, address ids.ShortID, assetID ids.ID, txCount int) []ids.ID {
-	require := require.New(t)
-
 	testTxs := make([]ids.ID, txCount)
 	for i := 0; i < txCount; i++ {
 		testTxs[i] = ids.GenerateTestID()
 	}
+	putTestTxIndex(t, db, address, assetID, testTxs)
+	return testTxs
+}
+
+// putTestTxIndex indexes [testTxs], in order, under [address] and [assetID].
+func putTestTxIndex(t *testing.T, db *versiondb.Database, address ids.ShortID, assetID ids.ID, testTxs []ids.ID) {
+	require := require.New(t)
 
 	addressPrefixDB := prefixdb.New(address[:], db)
 	assetPrefixDB := prefixdb.New(assetID[:], addressPrefixDB)
@@ -328,5 +393,4 @@ func initTestTxIndex(t *testing.T, db *versiondb.Database, address ids.ShortID,
 	idxBytes := database.PackUInt64(uint64(len(testTxs)))
 	require.NoError(assetPrefixDB.Put([]byte("idx"), idxBytes))
 	require.NoError(db.Commit())
-	return testTxs
 }
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"encoding/binary"
+
+	"github.com/CaiJiJi/avalanchego/database"
+	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/utils/wrappers"
+	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
+)
+
+var (
+	_ txs.Visitor = (*memoGetter)(nil)
+
+	memoIndexPrefix = []byte("memoIndex")
+)
+
+// memoIndex maps the memos of accepted txs to their IDs.
+//
+// Each tx with a non-empty memo is stored under the key:
+// [memo] + [txID] + [len(memo)]
+// so that iterating over the keys that start with a memo prefix visits every
+// tx whose memo starts with that prefix.
+type memoIndex struct {
+	db database.Database
+}
+
+// Accept indexes [tx] under its memo. Txs without a memo aren't indexed.
+func (i *memoIndex) Accept(tx *txs.Tx) error {
+	g := &memoGetter{}
+	// The error is explicitly dropped here because no error is ever returned
+	// from the memoGetter.
+	_ = tx.Unsigned.Visit(g)
+	if len(g.memo) == 0 {
+		return nil
+	}
+	return i.db.Put(memoIndexKey(g.memo, tx.ID()), nil)
+}
+
+// Read returns the IDs of up to [pageSize] txs whose memo equals [memo], or
+// starts with [memo] if [prefix] is true, after skipping the first [cursor]
+// matches. Txs are ordered by the bytes of their memo followed by their ID.
+func (i *memoIndex) Read(memo []byte, prefix bool, cursor, pageSize uint64) ([]ids.ID, error) {
+	iter := i.db.NewIteratorWithPrefix(memo)
+	defer iter.Release()
+
+	var (
+		txIDs   []ids.ID
+		skipped uint64
+	)
+	for uint64(len(txIDs)) < pageSize && iter.Next() {
+		key := iter.Key()
+		memoLen := int(binary.BigEndian.Uint16(key[len(key)-wrappers.ShortLen:]))
+		// If the memo is shorter than [memo], the key only matched because of
+		// the bytes of the tx ID.
+		if memoLen < len(memo) || (!prefix && memoLen != len(memo)) {
+			continue
+		}
+		if skipped < cursor {
+			skipped++
+			continue
+		}
+
+		txID, err := ids.ToID(key[memoLen : memoLen+ids.IDLen])
+		if err != nil {
+			return nil, err
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, iter.Error()
+}
+
+func memoIndexKey(memo []byte, txID ids.ID) []byte {
+	key := make([]byte, len(memo)+ids.IDLen+wrappers.ShortLen)
+	copy(key, memo)
+	copy(key[len(memo):], txID[:])
+	binary.BigEndian.PutUint16(key[len(memo)+ids.IDLen:], uint16(len(memo)))
+	return key
+}
+
+// memoGetter returns the memo of a tx.
+type memoGetter struct {
+	memo []byte
+}
+
+func (g *memoGetter) BaseTx(tx *txs.BaseTx) error {
+	g.memo = tx.Memo
+	return nil
+}
+
+func (g *memoGetter) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) OperationTx(tx *txs.OperationTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) ImportTx(tx *txs.ImportTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) ExportTx(tx *txs.ExportTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
@@ -5,9 +5,12 @@ package network
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/network/p2p"
@@ -17,11 +20,15 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/logging"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs/mempool"
+
+	txmempool "github.com/CaiJiJi/avalanchego/vms/txs/mempool"
 )
 
 var (
 	_ common.AppHandler    = (*Network)(nil)
 	_ validators.Connector = (*Network)(nil)
+
+	ErrNoConflictingTx = errors.New("tx doesn't conflict with any tx in the mempool")
 )
 
 type Network struct {
@@ -209,3 +216,59 @@ func (n *Network) IssueTxFromRPCWithoutVerification(tx *txs.Tx) error {
 	n.txPushGossiper.Add(tx)
 	return nil
 }
+
+// ReplaceTxFromRPC attempts to add a tx to the mempool in place of the txs in
+// the mempool that consume any of the same inputs, after verifying it. The
+// conflicting txs are passed to [canReplace], and are only evicted if it
+// returns nil. If the tx is added to the mempool, it will attempt to push
+// gossip the tx to random peers in the network.
+//
+// If the tx is already in the mempool, mempool.ErrDuplicateTx will be
+// returned.
+// If the tx doesn't conflict with any tx in the mempool, ErrNoConflictingTx
+// will be returned.
+// If the tx is not added to the mempool, an error will be returned and the
+// conflicting txs will be left in the mempool.
+func (n *Network) ReplaceTxFromRPC(tx *txs.Tx, canReplace func(conflicts []*txs.Tx) error) error {
+	txID := tx.ID()
+	if n.mempool.Has(txID) {
+		return fmt.Errorf("%w: %s", txmempool.ErrDuplicateTx, txID)
+	}
+
+	inputs := tx.InputIDs()
+	var conflicts []*txs.Tx
+	n.mempool.Iterate(func(pendingTx *txs.Tx) bool {
+		if inputs.Overlaps(pendingTx.InputIDs()) {
+			conflicts = append(conflicts, pendingTx)
+		}
+		return true
+	})
+	if len(conflicts) == 0 {
+		return fmt.Errorf("%w: %s", ErrNoConflictingTx, txID)
+	}
+	if err := canReplace(conflicts); err != nil {
+		return err
+	}
+
+	// Verify the tx at the currently preferred state
+	if err := n.mempool.txVerifier.VerifyTx(tx); err != nil {
+		return err
+	}
+
+	n.mempool.Remove(conflicts...)
+	if err := n.mempool.AddWithoutVerification(tx); err != nil {
+		// Restore the txs that would have been replaced. These were verified
+		// when they were originally added.
+		for _, conflict := range conflicts {
+			if err := n.mempool.AddWithoutVerification(conflict); err != nil {
+				n.log.Debug("failed to restore replaced tx",
+					zap.Stringer("txID", conflict.ID()),
+					zap.Error(err),
+				)
+			}
+		}
+		return err
+	}
+	n.txPushGossiper.Add(tx)
+	return nil
+}
@@ -4,13 +4,20 @@
 package avm
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 
 	"github.com/CaiJiJi/avalanchego/api"
 	"github.com/CaiJiJi/avalanchego/database"
@@ -19,25 +26,59 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/utils/crypto/secp256k1"
 	"github.com/CaiJiJi/avalanchego/utils/formatting"
+	"github.com/CaiJiJi/avalanchego/utils/formatting/address"
 	"github.com/CaiJiJi/avalanchego/utils/logging"
 	"github.com/CaiJiJi/avalanchego/utils/set"
+	"github.com/CaiJiJi/avalanchego/vms/avm/block"
+	"github.com/CaiJiJi/avalanchego/vms/avm/fxs"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
 	"github.com/CaiJiJi/avalanchego/vms/components/keystore"
 	"github.com/CaiJiJi/avalanchego/vms/components/verify"
 	"github.com/CaiJiJi/avalanchego/vms/nftfx"
+	"github.com/CaiJiJi/avalanchego/vms/propertyfx"
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
+	"github.com/CaiJiJi/avalanchego/vms/txs/mempool"
 
 	avajson "github.com/CaiJiJi/avalanchego/utils/json"
 	safemath "github.com/CaiJiJi/avalanchego/utils/math"
+	blockbuilder "github.com/CaiJiJi/avalanchego/vms/avm/block/builder"
 )
 
 const (
 	// Max number of addresses that can be passed in as argument to GetUTXOs
 	maxGetUTXOsAddrs = 1024
 
+	// Max number of addresses that can be passed in as argument to
+	// GetMultiAddressTxs
+	maxGetMultiAddressTxsAddrs = 1024
+
+	// Max number of block IDs that can be passed in as argument to
+	// GetBlocksByID
+	maxGetBlocksByIDBlocks = 256
+
 	// Max number of items allowed in a page
 	maxPageSize uint64 = 1024
+
+	// Max number of UTXOs SuggestConsolidation suggests consuming in a single
+	// tx
+	maxConsolidationInputs = 256
+
+	// Default and max number of times IssueTxWithRetry resubmits a tx
+	defaultIssueTxMaxRetries = 3
+	maxIssueTxMaxRetries     = 10
+
+	// Default and max delay before IssueTxWithRetry first resubmits a tx
+	defaultIssueTxBackoff = 100 * time.Millisecond
+	maxIssueTxBackoff     = 5 * time.Second
+
+	// Max number of recently accepted blocks EstimateConfirmationTime
+	// averages the block interval over
+	maxBlockIntervalSamples = 16
+
+	ConfirmationFast   = "fast"
+	ConfirmationMedium = "medium"
+	ConfirmationSlow   = "slow"
 )
 
 var (
@@ -52,6 +93,19 @@ var (
 	errNoKeys             = errors.New("from addresses have no keys or funds")
 	errMissingPrivateKey  = errors.New("argument 'privateKey' not given")
 	errNotLinearized      = errors.New("chain is not linearized")
+	errMissingAtomicUTXO  = errors.New("atomic UTXO not found")
+	errDuplicateUTXO      = errors.New("duplicate atomic UTXO")
+	errUnspendableUTXO    = errors.New("atomic UTXO can't be spent")
+	errInvalidTimeRange   = errors.New("end time is before start time")
+	errTooManyBlockIDs    = errors.New("too many block IDs")
+	errBulkExportDisabled = errors.New("exportAllKeys is disabled")
+	errWrongNumCreds      = errors.New("wrong number of credentials")
+	errWrongSigLength     = errors.New("wrong signature length")
+	errInvalidHRP         = errors.New("invalid bech32 HRP")
+	errMemoIndexDisabled  = errors.New("memo indexing is disabled")
+	errNoMemo             = errors.New("no memo provided")
+	errMissingMaxAmount   = errors.New("maxAmount must be given for assets other than the fee asset")
+	errInsufficientFee    = errors.New("insufficient funds to pay the tx fee")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -83,29 +137,27 @@ func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, reply *api.G
 	}
 	reply.Encoding = args.Encoding
 
-	var result any
-	if args.Encoding == formatting.JSON {
-		block.InitCtx(s.vm.ctx)
-		for _, tx := range block.Txs() {
-			err := tx.Unsigned.Visit(&txInit{
-				tx:            tx,
-				ctx:           s.vm.ctx,
-				typeToFxIndex: s.vm.typeToFxIndex,
-				fxs:           s.vm.fxs,
-			})
-			if err != nil {
-				return err
+	if args.IncludeTxStatuses {
+		// Every tx in a block is a decision tx, so the txs of an accepted block
+		// are always Accepted. The requested block may still be processing, in
+		// which case its txs haven't been accepted yet and are Processing.
+		blkTxs := block.Txs()
+		reply.TxStatuses = make(map[ids.ID]choices.Status, len(blkTxs))
+		for _, tx := range blkTxs {
+			txID := tx.ID()
+			_, err := s.vm.state.GetTx(txID)
+			switch err {
+			case nil:
+				reply.TxStatuses[txID] = choices.Accepted
+			case database.ErrNotFound:
+				reply.TxStatuses[txID] = choices.Processing
+			default:
+				return fmt.Errorf("couldn't get tx %s: %w", txID, err)
 			}
 		}
-		result = block
-	} else {
-		result, err = formatting.Encode(args.Encoding, block.Bytes())
-		if err != nil {
-			return fmt.Errorf("couldn't encode block %s as string: %w", args.BlockID, err)
-		}
 	}
 
-	reply.Block, err = json.Marshal(result)
+	reply.Block, err = s.marshalBlock(block, args.Encoding)
 	return err
 }
 
@@ -138,10 +190,18 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
 	}
 
+	reply.Block, err = s.marshalBlock(block, args.Encoding)
+	return err
+}
+
+// marshalBlock returns [blk] in the provided encoding.
+//
+// Assumes [s.vm.ctx.Lock] is held.
+func (s *Service) marshalBlock(blk block.Block, encoding formatting.Encoding) (json.RawMessage, error) {
 	var result any
-	if args.Encoding == formatting.JSON {
-		block.InitCtx(s.vm.ctx)
-		for _, tx := range block.Txs() {
+	if encoding == formatting.JSON {
+		blk.InitCtx(s.vm.ctx)
+		for _, tx := range blk.Txs() {
 			err := tx.Unsigned.Visit(&txInit{
 				tx:            tx,
 				ctx:           s.vm.ctx,
@@ -149,28 +209,55 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 				fxs:           s.vm.fxs,
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
-		result = block
+		result = blk
 	} else {
-		result, err = formatting.Encode(args.Encoding, block.Bytes())
+		var err error
+		result, err = formatting.Encode(encoding, blk.Bytes())
 		if err != nil {
-			return fmt.Errorf("couldn't encode block %s as string: %w", blockID, err)
+			return nil, fmt.Errorf("couldn't encode block %s as string: %w", blk.ID(), err)
 		}
 	}
+	return json.Marshal(result)
+}
 
-	reply.Block, err = json.Marshal(result)
-	return err
+// GetBlocksByIDArgs are the arguments for calls to GetBlocksByID
+type GetBlocksByIDArgs struct {
+	BlockIDs []ids.ID            `json:"blockIDs"`
+	Encoding formatting.Encoding `json:"encoding"`
 }
 
-// GetHeight returns the height of the last accepted block.
-func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightResponse) error {
+// BlockByID is a block returned by GetBlocksByID
+type BlockByID struct {
+	BlockID ids.ID          `json:"blockID"`
+	Block   json.RawMessage `json:"block"`
+}
+
+// GetBlocksByIDReply is the response from calls to GetBlocksByID
+type GetBlocksByIDReply struct {
+	// Blocks are ordered as they were requested
+	Blocks []BlockByID `json:"blocks"`
+	// Missing are the requested block IDs that weren't found
+	Missing  []ids.ID            `json:"missing"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetBlocksByID returns the blocks with the given IDs. Blocks that aren't
+// found are reported in [reply.Missing] rather than failing the call.
+func (s *Service) GetBlocksByID(_ *http.Request, args *GetBlocksByIDArgs, reply *GetBlocksByIDReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "getHeight"),
+		zap.String("method", "getBlocksByID"),
+		zap.Int("numBlocks", len(args.BlockIDs)),
+		zap.Stringer("encoding", args.Encoding),
 	)
 
+	if len(args.BlockIDs) > maxGetBlocksByIDBlocks {
+		return fmt.Errorf("%w: %d > %d", errTooManyBlockIDs, len(args.BlockIDs), maxGetBlocksByIDBlocks)
+	}
+
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
@@ -178,214 +265,1166 @@ func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightRe
 		return errNotLinearized
 	}
 
-	blockID := s.vm.state.GetLastAccepted()
-	block, err := s.vm.chainManager.GetStatelessBlock(blockID)
-	if err != nil {
-		s.vm.ctx.Log.Error("couldn't get last accepted block",
-			zap.Stringer("blkID", blockID),
-			zap.Error(err),
-		)
-		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
-	}
+	reply.Blocks = make([]BlockByID, 0, len(args.BlockIDs))
+	reply.Missing = []ids.ID{}
+	reply.Encoding = args.Encoding
+	for _, blkID := range args.BlockIDs {
+		block, err := s.vm.chainManager.GetStatelessBlock(blkID)
+		if err == database.ErrNotFound {
+			reply.Missing = append(reply.Missing, blkID)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("couldn't get block with id %s: %w", blkID, err)
+		}
 
-	reply.Height = avajson.Uint64(block.Height())
+		blockBytes, err := s.marshalBlock(block, args.Encoding)
+		if err != nil {
+			return err
+		}
+		reply.Blocks = append(reply.Blocks, BlockByID{
+			BlockID: blkID,
+			Block:   blockBytes,
+		})
+	}
 	return nil
 }
 
-// IssueTx attempts to issue a transaction into consensus
-func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSONTxID) error {
+// GetBlockRangeByTimeArgs are the arguments for calls to GetBlockRangeByTime
+type GetBlockRangeByTimeArgs struct {
+	// StartTime is the inclusive unix time, in seconds, of the window start
+	StartTime avajson.Uint64 `json:"startTime"`
+	// EndTime is the inclusive unix time, in seconds, of the window end
+	EndTime avajson.Uint64 `json:"endTime"`
+	// Cursor is the height to resume from, as returned by a previous call. If
+	// empty, the blocks are returned from the start of the window.
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// AcceptedBlock identifies an accepted block
+type AcceptedBlock struct {
+	BlockID   ids.ID         `json:"blockID"`
+	Height    avajson.Uint64 `json:"height"`
+	Timestamp avajson.Uint64 `json:"timestamp"`
+}
+
+// GetBlockRangeByTimeReply is the response from calls to GetBlockRangeByTime
+type GetBlockRangeByTimeReply struct {
+	// Blocks are ordered by increasing height
+	Blocks []AcceptedBlock `json:"blocks"`
+	// Truncated is true if more blocks in the window can be fetched by
+	// providing [Cursor] in another call.
+	Truncated bool `json:"truncated"`
+	// Cursor is the height to resume from to fetch the rest of the window
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetBlockRangeByTime returns the accepted blocks whose timestamps are within
+// the provided window. At most [maxPageSize] blocks are returned, starting with
+// the lowest height in the window that is at least the provided cursor.
+func (s *Service) GetBlockRangeByTime(_ *http.Request, args *GetBlockRangeByTimeArgs, reply *GetBlockRangeByTimeReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "issueTx"),
-		logging.UserString("tx", args.Tx),
+		zap.String("method", "getBlockRangeByTime"),
+		zap.Uint64("startTime", uint64(args.StartTime)),
+		zap.Uint64("endTime", uint64(args.EndTime)),
 	)
 
-	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if args.EndTime < args.StartTime {
+		return fmt.Errorf("%w: startTime = %d, endTime = %d", errInvalidTimeRange, args.StartTime, args.EndTime)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+
+	lastAcceptedID := s.vm.state.GetLastAccepted()
+	lastAccepted, err := s.vm.chainManager.GetStatelessBlock(lastAcceptedID)
 	if err != nil {
-		return fmt.Errorf("problem decoding transaction: %w", err)
+		return fmt.Errorf("couldn't get block with id %s: %w", lastAcceptedID, err)
 	}
 
-	tx, err := s.vm.parser.ParseTx(txBytes)
+	// Block timestamps are monotonically non-decreasing with height, so the
+	// window is the contiguous range of heights [start, end).
+	startTime := time.Unix(int64(args.StartTime), 0)
+	endTime := time.Unix(int64(args.EndTime), 0)
+	lastAcceptedHeight := lastAccepted.Height()
+	start, err := s.searchAcceptedHeight(lastAcceptedHeight, func(timestamp time.Time) bool {
+		return !timestamp.Before(startTime)
+	})
 	if err != nil {
-		s.vm.ctx.Log.Debug("failed to parse tx",
-			zap.Error(err),
-		)
 		return err
 	}
+	end, err := s.searchAcceptedHeight(lastAcceptedHeight, func(timestamp time.Time) bool {
+		return timestamp.After(endTime)
+	})
+	if err != nil {
+		return err
+	}
+	start = max(start, uint64(args.Cursor))
+	pageEnd := min(end, start+maxPageSize)
 
-	reply.TxID, err = s.vm.issueTxFromRPC(tx)
-	return err
+	reply.Blocks = make([]AcceptedBlock, 0, max(start, pageEnd)-start)
+	for height := start; height < pageEnd; height++ {
+		blk, err := s.getAcceptedBlock(height)
+		if err != nil {
+			return err
+		}
+		reply.Blocks = append(reply.Blocks, AcceptedBlock{
+			BlockID:   blk.ID(),
+			Height:    avajson.Uint64(height),
+			Timestamp: avajson.Uint64(blk.Timestamp().Unix()),
+		})
+	}
+	reply.Truncated = pageEnd < end
+	reply.Cursor = avajson.Uint64(max(start, pageEnd))
+	return nil
 }
 
-// GetTxStatusReply defines the GetTxStatus replies returned from the API
-type GetTxStatusReply struct {
-	Status choices.Status `json:"status"`
+// searchAcceptedHeight returns the lowest accepted height, up to
+// [lastAcceptedHeight], whose block timestamp satisfies [f]. If no such height
+// exists, lastAcceptedHeight+1 is returned.
+//
+// [f] must be false for all timestamps before, and true for all timestamps
+// after, some point in time.
+func (s *Service) searchAcceptedHeight(lastAcceptedHeight uint64, f func(time.Time) bool) (uint64, error) {
+	low, high := uint64(0), lastAcceptedHeight+1
+	for low < high {
+		mid := low + (high-low)/2
+		blk, err := s.getAcceptedBlock(mid)
+		if err != nil {
+			return 0, err
+		}
+		if f(blk.Timestamp()) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
 }
 
-type GetAddressTxsArgs struct {
-	api.JSONAddress
-	// Cursor used as a page index / offset
-	Cursor avajson.Uint64 `json:"cursor"`
-	// PageSize num of items per page
-	PageSize avajson.Uint64 `json:"pageSize"`
-	// AssetID defaulted to AVAX if omitted or left blank
-	AssetID string `json:"assetID"`
+func (s *Service) getAcceptedBlock(height uint64) (block.Block, error) {
+	blockID, err := s.vm.state.GetBlockIDAtHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get block at height %d: %w", height, err)
+	}
+	blk, err := s.vm.chainManager.GetStatelessBlock(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
+	}
+	return blk, nil
 }
 
-type GetAddressTxsReply struct {
-	TxIDs []ids.ID `json:"txIDs"`
-	// Cursor used as a page index / offset
-	Cursor avajson.Uint64 `json:"cursor"`
+// ArgSchemasReply defines the GetArgSchemas replies returned from the API
+type ArgSchemasReply struct {
+	// Schemas maps the name of each argument type to its JSON schema
+	Schemas map[string]*JSONSchema `json:"schemas"`
 }
 
-// GetAddressTxs returns list of transactions for a given address
-func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
-	cursor := uint64(args.Cursor)
-	pageSize := uint64(args.PageSize)
-	s.vm.ctx.Log.Warn("deprecated API called",
+// GetArgSchemas returns the JSON schemas of the arguments of the most common
+// API methods.
+func (s *Service) GetArgSchemas(_ *http.Request, _ *struct{}, reply *ArgSchemasReply) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "getAddressTxs"),
-		logging.UserString("address", args.Address),
-		logging.UserString("assetID", args.AssetID),
-		zap.Uint64("cursor", cursor),
-		zap.Uint64("pageSize", pageSize),
+		zap.String("method", "getArgSchemas"),
 	)
-	if pageSize > maxPageSize {
-		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
-	} else if pageSize == 0 {
-		pageSize = maxPageSize
-	}
 
-	// Parse to address
-	address, err := avax.ParseServiceAddress(s.vm, args.Address)
-	if err != nil {
-		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	argTypes := []reflect.Type{
+		reflect.TypeOf(CreateAssetArgs{}),
+		reflect.TypeOf(CreateNFTAssetArgs{}),
+		reflect.TypeOf(MintArgs{}),
+		reflect.TypeOf(SendArgs{}),
+		reflect.TypeOf(SendMultipleArgs{}),
+		reflect.TypeOf(ImportArgs{}),
+		reflect.TypeOf(ExportArgs{}),
+		reflect.TypeOf(api.GetUTXOsArgs{}),
 	}
-
-	// Lookup assetID
-	assetID, err := s.vm.lookupAssetID(args.AssetID)
-	if err != nil {
-		return fmt.Errorf("specified `assetID` is invalid: %w", err)
+	reply.Schemas = make(map[string]*JSONSchema, len(argTypes))
+	for _, argType := range argTypes {
+		reply.Schemas[argType.Name()] = newJSONSchema(argType)
 	}
+	return nil
+}
 
-	s.vm.ctx.Log.Debug("fetching transactions",
-		logging.UserString("address", args.Address),
-		logging.UserString("assetID", args.AssetID),
-		zap.Uint64("cursor", cursor),
-		zap.Uint64("pageSize", pageSize),
-	)
-
-	s.vm.ctx.Lock.Lock()
-	defer s.vm.ctx.Lock.Unlock()
+// GetFeeConfigReply defines the GetFeeConfig replies returned from the API
+type GetFeeConfigReply struct {
+	// Fee that is burned by every non-asset creating transaction
+	TxFee avajson.Uint64 `json:"txFee"`
+	// Fee that is burned by every asset creating transaction
+	CreateAssetTxFee avajson.Uint64 `json:"createAssetTxFee"`
+}
 
-	// Read transactions from the indexer
-	reply.TxIDs, err = s.vm.addressTxsIndexer.Read(address[:], assetID, cursor, pageSize)
-	if err != nil {
-		return err
-	}
-	s.vm.ctx.Log.Debug("fetched transactions",
-		logging.UserString("address", args.Address),
-		logging.UserString("assetID", args.AssetID),
-		zap.Int("numTxs", len(reply.TxIDs)),
+// GetFeeConfig returns the fees charged for issuing transactions.
+func (s *Service) GetFeeConfig(_ *http.Request, _ *struct{}, reply *GetFeeConfigReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getFeeConfig"),
 	)
 
-	// To get the next set of tx IDs, the user should provide this cursor.
-	// e.g. if they provided cursor 5, and read 6 tx IDs, they should start
-	// next time from index (cursor) 11.
-	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	reply.TxFee = avajson.Uint64(s.vm.TxFee)
+	reply.CreateAssetTxFee = avajson.Uint64(s.vm.CreateAssetTxFee)
 	return nil
 }
 
-// GetTxStatus returns the status of the specified transaction
+// EstimateConfirmationTimeReply is the response from calls to
+// EstimateConfirmationTime
+type EstimateConfirmationTimeReply struct {
+	// Blocks is the number of blocks expected to be accepted before the tx is
+	// included, including the block that includes it
+	Blocks avajson.Uint64 `json:"blocks"`
+	// BlockInterval is the average number of seconds between recently
+	// accepted blocks
+	BlockInterval avajson.Float64 `json:"blockInterval"`
+	// EstimatedTime is the estimated number of seconds until the tx is
+	// included
+	EstimatedTime avajson.Float64 `json:"estimatedTime"`
+	// Speed is one of [ConfirmationFast], [ConfirmationMedium], or
+	// [ConfirmationSlow]
+	Speed string `json:"speed"`
+}
+
+// EstimateConfirmationTime returns a coarse estimate of how long a tx issued
+// now would take to be included in an accepted block.
 //
-// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
-// used instead to determine if the tx was accepted.
-func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxStatusReply) error {
-	s.vm.ctx.Log.Debug("deprecated API called",
+// Txs are built into blocks in the order they were added to the mempool,
+// regardless of the fee they burn, and blocks are built up to
+// [blockbuilder.TargetBlockSize] bytes. So, a tx is expected to be included
+// once every tx currently in the mempool has been included. The number of
+// blocks is multiplied by the average interval between the last
+// [maxBlockIntervalSamples] accepted blocks. If there is no block history, the
+// interval is reported as 0.
+//
+// Consensus issues up to [config.Config.OptimalProcessing] blocks before any of
+// them are accepted. A tx included in the next block is fast. A tx whose block
+// can be issued alongside the blocks ahead of it is medium. Any other tx must
+// wait for blocks to be accepted before its block is issued, so it is slow.
+func (s *Service) EstimateConfirmationTime(_ *http.Request, _ *struct{}, reply *EstimateConfirmationTimeReply) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "getTxStatus"),
-		zap.Stringer("txID", args.TxID),
+		zap.String("method", "estimateConfirmationTime"),
 	)
 
-	if args.TxID == ids.Empty {
-		return errNilTxID
-	}
-
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	_, err := s.vm.state.GetTx(args.TxID)
-	switch err {
-	case nil:
-		reply.Status = choices.Accepted
-	case database.ErrNotFound:
-		reply.Status = choices.Unknown
-	default:
-		return err
+	if s.vm.chainManager == nil {
+		return errNotLinearized
 	}
-	return nil
-}
 
-// GetTx returns the specified transaction
-func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxReply) error {
-	s.vm.ctx.Log.Debug("API called",
-		zap.String("service", "avm"),
-		zap.String("method", "getTx"),
-		zap.Stringer("txID", args.TxID),
-	)
+	pendingSize := 0
+	s.vm.mempool.Iterate(func(tx *txs.Tx) bool {
+		pendingSize += tx.Size()
+		return true
+	})
+	blocks := uint64(pendingSize/blockbuilder.TargetBlockSize + 1)
 
-	if args.TxID == ids.Empty {
-		return errNilTxID
+	blockInterval, err := s.averageBlockInterval()
+	if err != nil {
+		return err
 	}
 
-	s.vm.ctx.Lock.Lock()
-	defer s.vm.ctx.Lock.Unlock()
+	reply.Blocks = avajson.Uint64(blocks)
+	reply.BlockInterval = avajson.Float64(blockInterval.Seconds())
+	reply.EstimatedTime = avajson.Float64(float64(blocks) * blockInterval.Seconds())
+	switch {
+	case blocks <= 1:
+		reply.Speed = ConfirmationFast
+	case blocks <= uint64(s.vm.OptimalProcessing):
+		reply.Speed = ConfirmationMedium
+	default:
+		reply.Speed = ConfirmationSlow
+	}
+	return nil
+}
 
-	tx, err := s.vm.state.GetTx(args.TxID)
+// averageBlockInterval returns the average interval between the last
+// [maxBlockIntervalSamples] accepted blocks, or 0 if only the genesis block has
+// been accepted.
+func (s *Service) averageBlockInterval() (time.Duration, error) {
+	lastAcceptedID := s.vm.state.GetLastAccepted()
+	lastAccepted, err := s.vm.chainManager.GetStatelessBlock(lastAcceptedID)
 	if err != nil {
-		return err
+		return 0, fmt.Errorf("couldn't get block with id %s: %w", lastAcceptedID, err)
 	}
-	reply.Encoding = args.Encoding
 
-	var result any
-	if args.Encoding == formatting.JSON {
-		err = tx.Unsigned.Visit(&txInit{
-			tx:            tx,
-			ctx:           s.vm.ctx,
-			typeToFxIndex: s.vm.typeToFxIndex,
-			fxs:           s.vm.fxs,
-		})
-		result = tx
-	} else {
-		result, err = formatting.Encode(args.Encoding, tx.Bytes())
+	lastAcceptedHeight := lastAccepted.Height()
+	samples := min(lastAcceptedHeight, maxBlockIntervalSamples)
+	if samples == 0 {
+		return 0, nil
 	}
+
+	first, err := s.getAcceptedBlock(lastAcceptedHeight - samples)
 	if err != nil {
-		return err
+		return 0, err
 	}
+	elapsed := lastAccepted.Timestamp().Sub(first.Timestamp())
+	return elapsed / time.Duration(samples), nil
+}
 
-	reply.Tx, err = json.Marshal(result)
-	return err
+// GenesisHolder is an output of a genesis asset
+type GenesisHolder struct {
+	Amount    avajson.Uint64 `json:"amount"`
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
 }
 
-// GetUTXOs gets all utxos for passed in addresses
-func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.GetUTXOsReply) error {
+// GenesisAssetDescription describes an asset created at genesis
+type GenesisAssetDescription struct {
+	GetAssetDescriptionReply
+	Alias          string          `json:"alias"`
+	InitialHolders []GenesisHolder `json:"initialHolders"`
+}
+
+// GetGenesisAssetsReply defines the GetGenesisAssets replies returned from the
+// API
+type GetGenesisAssetsReply struct {
+	Assets []GenesisAssetDescription `json:"assets"`
+}
+
+// GetGenesisAssets returns the assets created at genesis, in the order they
+// were defined, along with the outputs they were initially allocated to.
+func (s *Service) GetGenesisAssets(_ *http.Request, _ *struct{}, reply *GetGenesisAssetsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "getUTXOs"),
-		logging.UserStrings("addresses", args.Addresses),
+		zap.String("method", "getGenesisAssets"),
 	)
 
-	if len(args.Addresses) == 0 {
-		return errNoAddresses
-	}
-	if len(args.Addresses) > maxGetUTXOsAddrs {
-		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
-	}
+	reply.Assets = make([]GenesisAssetDescription, len(s.vm.genesisTxs))
+	for i, tx := range s.vm.genesisTxs {
+		createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx)
+		if !ok {
+			return errTxNotCreateAsset
+		}
 
-	var sourceChain ids.ID
-	if args.SourceChain == "" {
-		sourceChain = s.vm.ctx.ChainID
-	} else {
+		assetID := tx.ID()
+		alias, err := s.vm.PrimaryAlias(assetID)
+		if err != nil {
+			return err
+		}
+
+		asset := &reply.Assets[i]
+		asset.AssetID = assetID
+		asset.Name = createAssetTx.Name
+		asset.Symbol = createAssetTx.Symbol
+		asset.Denomination = avajson.Uint8(createAssetTx.Denomination)
+		asset.Alias = alias
+		asset.InitialHolders = []GenesisHolder{}
+		for _, utxo := range tx.UTXOs() {
+			out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+			if !ok {
+				continue
+			}
+
+			addrs := make([]string, len(out.Addrs))
+			for j, addr := range out.Addrs {
+				addrs[j], err = s.vm.FormatLocalAddress(addr)
+				if err != nil {
+					return err
+				}
+			}
+			asset.InitialHolders = append(asset.InitialHolders, GenesisHolder{
+				Amount:    avajson.Uint64(out.Amt),
+				Locktime:  avajson.Uint64(out.Locktime),
+				Threshold: avajson.Uint32(out.Threshold),
+				Addresses: addrs,
+			})
+		}
+	}
+	return nil
+}
+
+// GetHeight returns the height of the last accepted block.
+func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getHeight"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+
+	blockID := s.vm.state.GetLastAccepted()
+	block, err := s.vm.chainManager.GetStatelessBlock(blockID)
+	if err != nil {
+		s.vm.ctx.Log.Error("couldn't get last accepted block",
+			zap.Stringer("blkID", blockID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
+	}
+
+	reply.Height = avajson.Uint64(block.Height())
+	return nil
+}
+
+// IssueTx attempts to issue a transaction into consensus
+func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "issueTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx, err := s.vm.parseTxFromRPC(txBytes)
+	if err != nil {
+		s.vm.ctx.Log.Debug("failed to parse tx",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	reply.TxID, err = s.vm.issueTxFromRPC(tx)
+	return err
+}
+
+// IssueTxFromPartsArgs are arguments for calling IssueTxFromParts
+type IssueTxFromPartsArgs struct {
+	// UnsignedTx is the serialized unsigned tx
+	UnsignedTx string `json:"unsignedTx"`
+	// Signatures[i] are the signatures of the i-th credential of the tx
+	Signatures [][]string          `json:"signatures"`
+	Encoding   formatting.Encoding `json:"encoding"`
+}
+
+// IssueTxFromParts assembles a signed transaction from its unsigned bytes and
+// signatures over the hash of those bytes, and then issues it into consensus.
+//
+// Credentials are matched to the inputs and operations of the tx by index.
+// Each credential is assembled as a credential of the fx of its input or
+// operation.
+func (s *Service) IssueTxFromParts(_ *http.Request, args *IssueTxFromPartsArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "issueTxFromParts"),
+		logging.UserString("unsignedTx", args.UnsignedTx),
+	)
+
+	unsignedBytes, err := formatting.Decode(args.Encoding, args.UnsignedTx)
+	if err != nil {
+		return fmt.Errorf("problem decoding unsigned transaction: %w", err)
+	}
+
+	codec := s.vm.parser.Codec()
+	var unsignedTx txs.UnsignedTx
+	parsedVersion, err := codec.Unmarshal(unsignedBytes, &unsignedTx)
+	if err != nil {
+		return fmt.Errorf("problem parsing unsigned transaction: %w", err)
+	}
+	if parsedVersion != txs.CodecVersion {
+		return fmt.Errorf("expected codec version %d but got %d", txs.CodecVersion, parsedVersion)
+	}
+
+	if numCreds := unsignedTx.NumCredentials(); len(args.Signatures) != numCreds {
+		return fmt.Errorf("%w: expected %d but got %d",
+			errWrongNumCreds,
+			numCreds,
+			len(args.Signatures),
+		)
+	}
+
+	creds, err := newCreds(unsignedTx)
+	if err != nil {
+		return fmt.Errorf("problem creating credentials: %w", err)
+	}
+
+	tx := &txs.Tx{
+		Unsigned: unsignedTx,
+		Creds:    make([]*fxs.FxCredential, len(args.Signatures)),
+	}
+	for i, sigStrs := range args.Signatures {
+		cred, err := secp256k1Credential(creds[i])
+		if err != nil {
+			return err
+		}
+		cred.Sigs = make([][secp256k1.SignatureLen]byte, len(sigStrs))
+		for j, sigStr := range sigStrs {
+			sig, err := formatting.Decode(args.Encoding, sigStr)
+			if err != nil {
+				return fmt.Errorf("problem decoding signature %d of credential %d: %w", j, i, err)
+			}
+			if len(sig) != secp256k1.SignatureLen {
+				return fmt.Errorf("%w: signature %d of credential %d has length %d",
+					errWrongSigLength,
+					j,
+					i,
+					len(sig),
+				)
+			}
+			copy(cred.Sigs[j][:], sig)
+		}
+		tx.Creds[i] = &fxs.FxCredential{Credential: creds[i]}
+	}
+
+	// The signed tx is reparsed so that it is treated exactly as if it had
+	// been passed to IssueTx.
+	signedBytes, err := codec.Marshal(txs.CodecVersion, tx)
+	if err != nil {
+		return fmt.Errorf("problem creating transaction: %w", err)
+	}
+	tx, err = s.vm.parseTxFromRPC(signedBytes)
+	if err != nil {
+		return err
+	}
+
+	reply.TxID, err = s.vm.issueTxFromRPC(tx)
+	return err
+}
+
+// IssueTxWithRetryArgs are arguments for calling IssueTxWithRetry
+type IssueTxWithRetryArgs struct {
+	api.FormattedTx
+	// MaxRetries is the maximum number of times the tx is resubmitted after
+	// being transiently rejected. If zero, a default is used.
+	MaxRetries avajson.Uint32 `json:"maxRetries"`
+	// Backoff is the number of milliseconds to wait before the first
+	// resubmission. The wait doubles after every resubmission. If zero, a
+	// default is used.
+	Backoff avajson.Uint64 `json:"backoff"`
+}
+
+// IssueTxWithRetry attempts to issue a transaction into consensus. If the tx is
+// rejected for a transient reason, such as the mempool being full, the tx is
+// resubmitted a bounded number of times with exponential backoff.
+func (s *Service) IssueTxWithRetry(r *http.Request, args *IssueTxWithRetryArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "issueTxWithRetry"),
+		logging.UserString("tx", args.Tx),
+		zap.Uint32("maxRetries", uint32(args.MaxRetries)),
+		zap.Uint64("backoff", uint64(args.Backoff)),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	// A tx that can't be parsed will never be accepted, so it isn't retried.
+	tx, err := s.vm.parseTxFromRPC(txBytes)
+	if err != nil {
+		s.vm.ctx.Log.Debug("failed to parse tx",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	maxRetries := uint32(defaultIssueTxMaxRetries)
+	if args.MaxRetries != 0 {
+		maxRetries = min(uint32(args.MaxRetries), maxIssueTxMaxRetries)
+	}
+	backoff := defaultIssueTxBackoff
+	if args.Backoff != 0 {
+		backoff = min(time.Duration(args.Backoff)*time.Millisecond, maxIssueTxBackoff)
+	}
+
+	return issueTxWithRetry(
+		r.Context(),
+		func() error {
+			txID, err := s.vm.issueTxFromRPC(tx)
+			reply.TxID = txID
+			return err
+		},
+		maxRetries,
+		backoff,
+	)
+}
+
+// ReplaceTxArgs are arguments for calling ReplaceTx
+type ReplaceTxArgs struct {
+	api.FormattedTx
+}
+
+// ReplaceTx issues a transaction in place of the pending transactions that
+// consume any of the same inputs. The replaced transactions are evicted from
+// the mempool only if the new transaction burns a sufficiently higher fee.
+func (s *Service) ReplaceTx(_ *http.Request, args *ReplaceTxArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "replaceTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx, err := s.vm.parseTxFromRPC(txBytes)
+	if err != nil {
+		s.vm.ctx.Log.Debug("failed to parse tx",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	reply.TxID, err = s.vm.replaceTxFromRPC(tx)
+	return err
+}
+
+// issueTxWithRetry calls [issue] until it succeeds, it fails for a reason that
+// isn't transient, or it has been retried [maxRetries] times. The delay before
+// the first retry is [backoff], and it doubles after every retry.
+func issueTxWithRetry(
+	ctx context.Context,
+	issue func() error,
+	maxRetries uint32,
+	backoff time.Duration,
+) error {
+	for retries := uint32(0); ; retries++ {
+		err := issue()
+		if err == nil || !isTransientIssueError(err) || retries >= maxRetries {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w: last attempt failed with: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientIssueError returns true if a tx that failed to be issued with
+// [err] may be issued successfully later without modification.
+func isTransientIssueError(err error) bool {
+	return errors.Is(err, mempool.ErrMempoolFull)
+}
+
+// DecodeTx returns the JSON representation of the provided tx, as GetTx would
+// return it, without requiring the tx to have been issued. The tx isn't
+// verified, and neither the state nor the mempool are modified.
+//
+// Unsigned txs can be decoded by serializing them without credentials.
+func (s *Service) DecodeTx(_ *http.Request, args *api.FormattedTx, reply *api.GetTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "decodeTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx, err := s.vm.parseTxFromRPC(txBytes)
+	if err != nil {
+		s.vm.ctx.Log.Debug("failed to parse tx",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = tx.Unsigned.Visit(&txInit{
+		tx:            tx,
+		ctx:           s.vm.ctx,
+		typeToFxIndex: s.vm.typeToFxIndex,
+		fxs:           s.vm.fxs,
+	})
+	if err != nil {
+		return err
+	}
+
+	reply.Encoding = formatting.JSON
+	reply.Tx, err = json.Marshal(tx)
+	return err
+}
+
+// GetTxStatusReply defines the GetTxStatus replies returned from the API
+type GetTxStatusReply struct {
+	Status choices.Status `json:"status"`
+	// Confirmations is the number of accepted blocks since, and including, the
+	// block that accepted the tx. It is zero if the tx isn't known to have
+	// been accepted in a block.
+	Confirmations avajson.Uint64 `json:"confirmations"`
+}
+
+type GetAddressTxsArgs struct {
+	api.JSONAddress
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+	// AssetID defaulted to AVAX if omitted or left blank
+	AssetID string `json:"assetID"`
+}
+
+type GetAddressTxsReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetAddressTxs returns list of transactions for a given address
+func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAddressTxs"),
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	// Parse to address
+	address, err := avax.ParseServiceAddress(s.vm, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	}
+
+	// Lookup assetID
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return fmt.Errorf("specified `assetID` is invalid: %w", err)
+	}
+
+	s.vm.ctx.Log.Debug("fetching transactions",
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	// Read transactions from the indexer
+	reply.TxIDs, err = s.vm.addressTxsIndexer.Read(address[:], assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+	s.vm.ctx.Log.Debug("fetched transactions",
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Int("numTxs", len(reply.TxIDs)),
+	)
+
+	// To get the next set of tx IDs, the user should provide this cursor.
+	// e.g. if they provided cursor 5, and read 6 tx IDs, they should start
+	// next time from index (cursor) 11.
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
+type GetMultiAddressTxsArgs struct {
+	api.JSONAddresses
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+	// AssetID defaulted to AVAX if omitted or left blank
+	AssetID string `json:"assetID"`
+}
+
+// GetMultiAddressTxs returns the deduplicated list of transactions that touch
+// any of the given addresses.
+//
+// The transactions of each address are listed in the order they were indexed,
+// and the addresses are listed in ascending order of their byte
+// representation. A transaction that touches multiple addresses is only listed
+// under the first of them. The cursor is an offset into this list.
+func (s *Service) GetMultiAddressTxs(_ *http.Request, args *GetMultiAddressTxsArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getMultiAddressTxs"),
+		zap.Int("numAddresses", len(args.Addresses)),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetMultiAddressTxsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetMultiAddressTxsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+	addrs := addrSet.List()
+	utils.Sort(addrs)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return fmt.Errorf("specified `assetID` is invalid: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.TxIDs, err = s.readMultiAddressTxs(addrs, assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
+type GetTxsByMemoArgs struct {
+	// Memo of the txs to return
+	Memo string `json:"memo"`
+	// If Prefix is true, the txs whose memo starts with [Memo] are returned
+	// rather than the txs whose memo equals [Memo]
+	Prefix bool `json:"prefix"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+// GetTxsByMemo returns the IDs of the accepted txs whose memo matches
+// [args.Memo]. The txs are ordered by the bytes of their memo followed by
+// their ID, and the cursor is an offset into this list.
+//
+// This requires memo indexing to be enabled in the config. Txs accepted while
+// it was disabled are not returned.
+func (s *Service) GetTxsByMemo(_ *http.Request, args *GetTxsByMemoArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxsByMemo"),
+		zap.Bool("prefix", args.Prefix),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	if s.vm.memoIndex == nil {
+		return errMemoIndexDisabled
+	}
+	if len(args.Memo) == 0 {
+		return errNoMemo
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var err error
+	reply.TxIDs, err = s.vm.memoIndex.Read([]byte(args.Memo), args.Prefix, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
+// readMultiAddressTxs returns up to [pageSize] txIDs of the merged list of txs
+// of [addrs], skipping the first [cursor] txIDs of the list. [addrs] must be
+// sorted.
+//
+// Because txs may be indexed under multiple addresses, deduplicating the list
+// requires reading every txID that precedes [cursor].
+func (s *Service) readMultiAddressTxs(addrs []ids.ShortID, assetID ids.ID, cursor, pageSize uint64) ([]ids.ID, error) {
+	var (
+		seen    set.Set[ids.ID]
+		skipped uint64
+		txIDs   []ids.ID
+	)
+	for _, addr := range addrs {
+		var addrCursor uint64
+		for {
+			page, err := s.vm.addressTxsIndexer.Read(addr[:], assetID, addrCursor, maxPageSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, txID := range page {
+				if seen.Contains(txID) {
+					continue
+				}
+				seen.Add(txID)
+
+				if skipped < cursor {
+					skipped++
+					continue
+				}
+				txIDs = append(txIDs, txID)
+				if uint64(len(txIDs)) == pageSize {
+					return txIDs, nil
+				}
+			}
+			if uint64(len(page)) < maxPageSize {
+				break
+			}
+			addrCursor += uint64(len(page))
+		}
+	}
+	return txIDs, nil
+}
+
+// GetTxStatus returns the status of the specified transaction
+//
+// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
+// used instead to determine if the tx was accepted.
+func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxStatusReply) error {
+	s.vm.ctx.Log.Debug("deprecated API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxStatus"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNilTxID
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	_, err := s.vm.state.GetTx(args.TxID)
+	switch err {
+	case nil:
+		reply.Status = choices.Accepted
+	case database.ErrNotFound:
+		reply.Status = choices.Unknown
+		return nil
+	default:
+		return err
+	}
+
+	blkID, err := s.vm.state.GetTxBlockID(args.TxID)
+	if err == database.ErrNotFound {
+		// The tx wasn't accepted in a block.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get block of tx %s: %w", args.TxID, err)
+	}
+	blk, err := s.vm.state.GetBlock(blkID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block with id %s: %w", blkID, err)
+	}
+	lastAcceptedID := s.vm.state.GetLastAccepted()
+	lastAccepted, err := s.vm.state.GetBlock(lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	reply.Confirmations = avajson.Uint64(lastAccepted.Height() - blk.Height() + 1)
+	return nil
+}
+
+// unsignedTxReply is the JSON representation of a tx without its credentials.
+type unsignedTxReply struct {
+	Unsigned txs.UnsignedTx `json:"unsignedTx"`
+	TxID     ids.ID         `json:"id"`
+}
+
+// GetTx returns the specified transaction
+func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTx"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNilTxID
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	tx, err := s.vm.state.GetTx(args.TxID)
+	if err != nil {
+		return err
+	}
+	reply.Encoding = args.Encoding
+
+	var result any
+	if args.Encoding == formatting.JSON {
+		err = tx.Unsigned.Visit(&txInit{
+			tx:            tx,
+			ctx:           s.vm.ctx,
+			typeToFxIndex: s.vm.typeToFxIndex,
+			fxs:           s.vm.fxs,
+		})
+		result = tx
+		if args.OmitCredentials {
+			result = &unsignedTxReply{
+				Unsigned: tx.Unsigned,
+				TxID:     tx.TxID,
+			}
+		}
+	} else {
+		result, err = formatting.Encode(args.Encoding, tx.Bytes())
+	}
+	if err != nil {
+		return err
+	}
+
+	reply.Tx, err = json.Marshal(result)
+	if err != nil || !args.IncludeBlockContext {
+		return err
+	}
+
+	blkID, err := s.vm.state.GetTxBlockID(args.TxID)
+	if err == database.ErrNotFound {
+		// The tx wasn't accepted in a block.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get block of tx %s: %w", args.TxID, err)
+	}
+	blk, err := s.vm.state.GetBlock(blkID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block with id %s: %w", blkID, err)
+	}
+	reply.BlockContext = &api.TxBlockContext{
+		BlockID:   blkID,
+		Height:    avajson.Uint64(blk.Height()),
+		Timestamp: avajson.Uint64(blk.Timestamp().Unix()),
+	}
+	return nil
+}
+
+// TxInputUTXO is the UTXO consumed by an input of a tx
+type TxInputUTXO struct {
+	UTXOID avax.UTXOID `json:"utxoID"`
+	// Pruned is true if this node no longer retains the consumed UTXO. UTXOs
+	// imported from other chains are always reported as pruned.
+	Pruned bool `json:"pruned"`
+	// UTXO is the JSON representation of the consumed UTXO, including its
+	// amount and owners. It is empty if [Pruned] is true.
+	UTXO json.RawMessage `json:"utxo,omitempty"`
+}
+
+// GetTxInputUTXOsReply defines the GetTxInputUTXOs replies returned from the
+// API
+type GetTxInputUTXOsReply struct {
+	// UTXOs are in the same order as the tx's inputs
+	UTXOs []TxInputUTXO `json:"utxos"`
+}
+
+// GetTxInputUTXOs returns the UTXOs consumed by the inputs of the accepted tx
+// [args.TxID]. Because spent UTXOs are removed from the state, each UTXO is
+// rebuilt from the tx that produced it.
+func (s *Service) GetTxInputUTXOs(_ *http.Request, args *api.JSONTxID, reply *GetTxInputUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxInputUTXOs"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNilTxID
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	tx, err := s.vm.state.GetTx(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get tx %s: %w", args.TxID, err)
+	}
+
+	utxoIDs := tx.Unsigned.InputUTXOs()
+	reply.UTXOs = make([]TxInputUTXO, len(utxoIDs))
+	for i, utxoID := range utxoIDs {
+		reply.UTXOs[i].UTXOID = *utxoID
+
+		utxo, err := s.getSpentUTXO(utxoID)
+		if err != nil {
+			return err
+		}
+		if utxo == nil {
+			reply.UTXOs[i].Pruned = true
+			continue
+		}
+
+		reply.UTXOs[i].UTXO, err = json.Marshal(utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't marshal UTXO %s: %w", utxoID, err)
+		}
+	}
+	return nil
+}
+
+// getSpentUTXO returns the UTXO [utxoID] as it was produced by its source tx,
+// or nil if the source tx isn't stored on this chain.
+//
+// Invariant: The context lock is held.
+func (s *Service) getSpentUTXO(utxoID *avax.UTXOID) (*avax.UTXO, error) {
+	sourceTx, err := s.vm.state.GetTx(utxoID.TxID)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tx %s: %w", utxoID.TxID, err)
+	}
+
+	sourceTx.Unsigned.InitCtx(s.vm.ctx)
+	inputID := utxoID.InputID()
+	for _, utxo := range sourceTx.UTXOs() {
+		if utxo.InputID() == inputID {
+			return utxo, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetUTXOs gets all utxos for passed in addresses
+func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.GetUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getUTXOs"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	// The deadline starts when the request is received, so that time spent
+	// waiting for the lock counts against it.
+	var deadline time.Time
+	if s.vm.getUTXOsDeadline > 0 {
+		deadline = time.Now().Add(s.vm.getUTXOsDeadline)
+	}
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	var sourceChain ids.ID
+	if args.SourceChain == "" {
+		sourceChain = s.vm.ctx.ChainID
+	} else {
 		chainID, err := s.vm.ctx.BCLookup.Lookup(args.SourceChain)
 		if err != nil {
 			return fmt.Errorf("problem parsing source chainID %q: %w", args.SourceChain, err)
@@ -415,6 +1454,7 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 		utxos     []*avax.UTXO
 		endAddr   ids.ShortID
 		endUTXOID ids.ID
+		truncated bool
 	)
 	limit := int(args.Limit)
 	if limit <= 0 || int(maxPageSize) < limit {
@@ -425,14 +1465,17 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	defer s.vm.ctx.Lock.Unlock()
 
 	if sourceChain == s.vm.ctx.ChainID {
-		utxos, endAddr, endUTXOID, err = avax.GetPaginatedUTXOs(
+		utxos, endAddr, endUTXOID, truncated, err = avax.GetPaginatedUTXOsWithDeadline(
 			s.vm.state,
 			addrSet,
 			startAddr,
 			startUTXO,
 			limit,
+			deadline,
 		)
 	} else {
+		// Atomic UTXOs are read from shared memory in a single batch, so the
+		// deadline isn't applied.
 		utxos, endAddr, endUTXOID, err = avax.GetAtomicUTXOs(
 			s.vm.ctx.SharedMemory,
 			s.vm.parser.Codec(),
@@ -447,31 +1490,183 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 		return fmt.Errorf("problem retrieving UTXOs: %w", err)
 	}
 
-	reply.UTXOs = make([]string, len(utxos))
-	codec := s.vm.parser.Codec()
-	for i, utxo := range utxos {
-		b, err := codec.Marshal(txs.CodecVersion, utxo)
+	if args.SpendableOnly {
+		// Filtering is applied after fetching the page, so that [endAddr] and
+		// [endUTXOID] still point to the last UTXO that was considered.
+		now := s.vm.clock.Unix()
+		spendable := utxos[:0]
+		for _, utxo := range utxos {
+			if isSpendable(utxo, addrSet, now) {
+				spendable = append(spendable, utxo)
+			}
+		}
+		utxos = spendable
+	}
+
+	reply.UTXOs = make([]string, len(utxos))
+	codec := s.vm.parser.Codec()
+	for i, utxo := range utxos {
+		b, err := codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("problem marshalling UTXO: %w", err)
+		}
+		reply.UTXOs[i], err = formatting.Encode(args.Encoding, b)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+		}
+	}
+
+	endAddress, err := s.vm.FormatLocalAddress(endAddr)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+
+	reply.EndIndex.Address = endAddress
+	reply.EndIndex.UTXO = endUTXOID.String()
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.Encoding = args.Encoding
+	reply.TruncatedByDeadline = truncated
+	return nil
+}
+
+// GetUTXOArgs are arguments for passing into GetUTXO requests
+type GetUTXOArgs struct {
+	UTXOID avax.UTXOID `json:"utxoID"`
+	// SourceChain is the chain that exported the UTXO into shared memory. If
+	// empty, the UTXO is looked up in this chain's state.
+	SourceChain string              `json:"sourceChain"`
+	Encoding    formatting.Encoding `json:"encoding"`
+}
+
+// GetUTXOReply defines the GetUTXO replies returned from the API
+type GetUTXOReply struct {
+	UTXO     string              `json:"utxo"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetUTXO returns the unspent UTXO [args.UTXOID]. If the UTXO doesn't exist,
+// or has already been spent, database.ErrNotFound is returned.
+func (s *Service) GetUTXO(_ *http.Request, args *GetUTXOArgs, reply *GetUTXOReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getUTXO"),
+		zap.Stringer("utxoID", &args.UTXOID),
+		logging.UserString("sourceChain", args.SourceChain),
+	)
+
+	sourceChain := s.vm.ctx.ChainID
+	if args.SourceChain != "" {
+		chainID, err := s.vm.ctx.BCLookup.Lookup(args.SourceChain)
+		if err != nil {
+			return fmt.Errorf("problem parsing source chainID %q: %w", args.SourceChain, err)
+		}
+		sourceChain = chainID
+	}
+
+	var (
+		inputID   = args.UTXOID.InputID()
+		utxoBytes []byte
+	)
+	if sourceChain == s.vm.ctx.ChainID {
+		s.vm.ctx.Lock.Lock()
+		defer s.vm.ctx.Lock.Unlock()
+
+		utxo, err := s.vm.state.GetUTXO(inputID)
+		if err != nil {
+			return fmt.Errorf("couldn't get UTXO %s: %w", &args.UTXOID, err)
+		}
+		utxoBytes, err = s.vm.parser.Codec().Marshal(txs.CodecVersion, utxo)
 		if err != nil {
 			return fmt.Errorf("problem marshalling UTXO: %w", err)
 		}
-		reply.UTXOs[i], err = formatting.Encode(args.Encoding, b)
+	} else {
+		// Atomic UTXOs are stored in shared memory in their serialized form.
+		values, err := s.vm.ctx.SharedMemory.Get(sourceChain, [][]byte{inputID[:]})
 		if err != nil {
-			return fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+			return fmt.Errorf("couldn't get atomic UTXO %s: %w", &args.UTXOID, err)
 		}
+		utxoBytes = values[0]
 	}
 
-	endAddress, err := s.vm.FormatLocalAddress(endAddr)
+	var err error
+	reply.UTXO, err = formatting.Encode(args.Encoding, utxoBytes)
 	if err != nil {
-		return fmt.Errorf("problem formatting address: %w", err)
+		return fmt.Errorf("couldn't encode UTXO %s as string: %w", &args.UTXOID, err)
 	}
-
-	reply.EndIndex.Address = endAddress
-	reply.EndIndex.UTXO = endUTXOID.String()
-	reply.NumFetched = avajson.Uint64(len(utxos))
 	reply.Encoding = args.Encoding
 	return nil
 }
 
+// GetTxsSpendingUTXOArgs are arguments for passing into GetTxsSpendingUTXO
+// requests
+type GetTxsSpendingUTXOArgs struct {
+	UTXOID avax.UTXOID `json:"utxoID"`
+}
+
+// GetTxsSpendingUTXOReply defines the GetTxsSpendingUTXO replies returned from
+// the API
+type GetTxsSpendingUTXOReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+}
+
+// GetTxsSpendingUTXO returns the IDs of the mempool txs that consume
+// [args.UTXOID]. Because the mempool rejects txs that conflict with a pending
+// tx, at most one txID is returned.
+func (s *Service) GetTxsSpendingUTXO(_ *http.Request, args *GetTxsSpendingUTXOArgs, reply *GetTxsSpendingUTXOReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxsSpendingUTXO"),
+		zap.Stringer("utxoID", &args.UTXOID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+
+	reply.TxIDs = []ids.ID{}
+	if tx, ok := s.vm.mempool.GetSpender(args.UTXOID.InputID()); ok {
+		reply.TxIDs = append(reply.TxIDs, tx.ID())
+	}
+	return nil
+}
+
+// isSpendable returns true if [addrs] can spend [utxo] at time [now]. That is,
+// the locktime of [utxo] has passed and [addrs] contains at least threshold of
+// its owners.
+func isSpendable(utxo *avax.UTXO, addrs set.Set[ids.ShortID], now uint64) bool {
+	var owners *secp256k1fx.OutputOwners
+	switch out := utxo.Out.(type) {
+	case *secp256k1fx.TransferOutput:
+		owners = &out.OutputOwners
+	case *secp256k1fx.MintOutput:
+		owners = &out.OutputOwners
+	case *nftfx.TransferOutput:
+		owners = &out.OutputOwners
+	case *nftfx.MintOutput:
+		owners = &out.OutputOwners
+	case *propertyfx.MintOutput:
+		owners = &out.OutputOwners
+	case *propertyfx.OwnedOutput:
+		owners = &out.OutputOwners
+	default:
+		return false
+	}
+
+	if owners.Locktime > now {
+		return false
+	}
+	var numOwned uint32
+	for _, addr := range owners.Addrs {
+		if addrs.Contains(addr) {
+			numOwned++
+		}
+	}
+	return numOwned >= owners.Threshold
+}
+
 // GetAssetDescriptionArgs are arguments for passing into GetAssetDescription requests
 type GetAssetDescriptionArgs struct {
 	AssetID string `json:"assetID"`
@@ -518,6 +1713,135 @@ func (s *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescription
 	return nil
 }
 
+// GetFeeAsset returns the description of the asset that is burned to pay
+// transaction fees.
+func (s *Service) GetFeeAsset(_ *http.Request, _ *struct{}, reply *GetAssetDescriptionReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getFeeAsset"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var err error
+	*reply, err = s.getAssetDescription(s.vm.feeAssetID)
+	return err
+}
+
+// GetAssetsArgs are arguments for passing into GetAssets requests
+type GetAssetsArgs struct {
+	// Cursor is the index of the first asset to return
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize is the maximum number of assets to return
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+// GetAssetsReply defines the GetAssets replies returned from the API
+type GetAssetsReply struct {
+	Assets []GetAssetDescriptionReply `json:"assets"`
+	// Cursor is the index to provide to fetch the next page of assets
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetAssets returns the assets created on this chain, in the order they were
+// accepted.
+func (s *Service) GetAssets(_ *http.Request, args *GetAssetsArgs, reply *GetAssetsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssets"),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	assetIDs, err := s.vm.state.AssetIDs(cursor, int(pageSize))
+	if err != nil {
+		return fmt.Errorf("couldn't get assetIDs: %w", err)
+	}
+
+	reply.Assets = make([]GetAssetDescriptionReply, len(assetIDs))
+	for i, assetID := range assetIDs {
+		reply.Assets[i], err = s.getAssetDescription(assetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// To get the next page of assets, the user should provide this cursor.
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(assetIDs)))
+	return nil
+}
+
+// GetAssetIDArgs are arguments for passing into GetAssetID requests
+type GetAssetIDArgs struct {
+	// Symbol or name of the asset
+	Query string `json:"query"`
+}
+
+// GetAssetIDReply defines the GetAssetID replies returned from the API
+type GetAssetIDReply struct {
+	Assets []GetAssetDescriptionReply `json:"assets"`
+}
+
+// GetAssetID returns the assets whose symbol or name is exactly
+// [args.Query], in the order they were accepted. Because neither symbols nor
+// names are unique, any number of assets may be returned.
+func (s *Service) GetAssetID(_ *http.Request, args *GetAssetIDArgs, reply *GetAssetIDReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssetID"),
+		logging.UserString("query", args.Query),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	assetIDs, err := s.vm.state.AssetIDsByName(args.Query)
+	if err != nil {
+		return fmt.Errorf("couldn't get assetIDs: %w", err)
+	}
+	reply.Assets = make([]GetAssetDescriptionReply, len(assetIDs))
+	for i, assetID := range assetIDs {
+		reply.Assets[i], err = s.getAssetDescription(assetID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getAssetDescription returns the description of the asset created by the
+// accepted tx [assetID].
+func (s *Service) getAssetDescription(assetID ids.ID) (GetAssetDescriptionReply, error) {
+	tx, err := s.vm.state.GetTx(assetID)
+	if err != nil {
+		return GetAssetDescriptionReply{}, fmt.Errorf("couldn't get asset %s: %w", assetID, err)
+	}
+	createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx)
+	if !ok {
+		return GetAssetDescriptionReply{}, fmt.Errorf("%w: %s", errTxNotCreateAsset, assetID)
+	}
+
+	return GetAssetDescriptionReply{
+		FormattedAssetID: FormattedAssetID{
+			AssetID: assetID,
+		},
+		Name:         createAssetTx.Name,
+		Symbol:       createAssetTx.Symbol,
+		Denomination: avajson.Uint8(createAssetTx.Denomination),
+	}, nil
+}
+
 // GetBalanceArgs are arguments for passing into GetBalance requests
 type GetBalanceArgs struct {
 	Address        string `json:"address"`
@@ -554,121 +1878,370 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceArgs, reply *GetBa
 		return err
 	}
 
-	addrSet := set.Of(addr)
-
+	addrSet := set.Of(addr)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	reply.UTXOIDs = make([]avax.UTXOID, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.AssetID() != assetID {
+			continue
+		}
+		// TODO make this not specific to *secp256k1fx.TransferOutput
+		transferable, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		owners := transferable.OutputOwners
+		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+			continue
+		}
+		amt, err := safemath.Add(transferable.Amount(), uint64(reply.Balance))
+		if err != nil {
+			return err
+		}
+		reply.Balance = avajson.Uint64(amt)
+		reply.UTXOIDs = append(reply.UTXOIDs, utxo.UTXOID)
+	}
+
+	return nil
+}
+
+type Balance struct {
+	AssetID string         `json:"asset"`
+	Balance avajson.Uint64 `json:"balance"`
+}
+
+type GetAllBalancesArgs struct {
+	api.JSONAddress
+	IncludePartial bool `json:"includePartial"`
+}
+
+// GetAllBalancesReply is the response from a call to GetAllBalances
+type GetAllBalancesReply struct {
+	Balances []Balance `json:"balances"`
+}
+
+// GetAllBalances returns a map where:
+//
+// Key: ID of an asset such that [args.Address] has a non-zero balance of the asset
+// Value: The balance of the asset held by the address
+//
+// If ![args.IncludePartial], returns only unlocked balance/UTXOs with a 1-out-of-1 multisig.
+// Otherwise, returned balance/UTXOs includes assets held only partially by the
+// address, and includes balances with locktime in the future.
+func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, reply *GetAllBalancesReply) error {
+	s.vm.ctx.Log.Debug("deprecated API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAllBalances"),
+		logging.UserString("address", args.Address),
+	)
+
+	address, err := avax.ParseServiceAddress(s.vm, args.Address)
+	if err != nil {
+		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	balances, err := s.getAllBalances(address, args.IncludePartial)
+	if err != nil {
+		return err
+	}
+
+	reply.Balances = make([]Balance, 0, len(balances))
+	for assetID, balance := range balances {
+		reply.Balances = append(reply.Balances, Balance{
+			AssetID: s.vm.PrimaryAliasOrDefault(assetID),
+			Balance: avajson.Uint64(balance),
+		})
+	}
+	return nil
+}
+
+// getAllBalances returns the non-zero balances of the assets held by
+// [address]. If a balance overflows, it is reported as [math.MaxUint64].
+//
+// If ![includePartial], only unlocked UTXOs with a 1-out-of-1 multisig are
+// counted.
+//
+// Invariant: The context lock must be held.
+func (s *Service) getAllBalances(address ids.ShortID, includePartial bool) (map[ids.ID]uint64, error) {
+	utxos, err := avax.GetAllUTXOs(s.vm.state, set.Of(address))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get address's UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	balances := make(map[ids.ID]uint64) // key: ID (as bytes). value: balance of that asset
+	for _, utxo := range utxos {
+		// TODO make this not specific to *secp256k1fx.TransferOutput
+		transferable, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		owners := transferable.OutputOwners
+		if !includePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+			continue
+		}
+		assetID := utxo.AssetID()
+		balance := balances[assetID] // 0 if key doesn't exist
+		balance, err := safemath.Add(transferable.Amount(), balance)
+		if err != nil {
+			balances[assetID] = math.MaxUint64
+		} else {
+			balances[assetID] = balance
+		}
+	}
+	return balances, nil
+}
+
+// GetBalanceUnlockScheduleArgs are the arguments for calls to
+// GetBalanceUnlockSchedule
+type GetBalanceUnlockScheduleArgs struct {
+	api.JSONAddress
+	IncludePartial bool `json:"includePartial"`
+}
+
+// UnlockEntry is an amount of an asset that becomes spendable at UnlockTime
+type UnlockEntry struct {
+	// UnlockTime is the unix time, in seconds, at which Amount unlocks
+	UnlockTime avajson.Uint64 `json:"unlockTime"`
+	Amount     avajson.Uint64 `json:"amount"`
+}
+
+// AssetUnlockSchedule is the schedule on which an asset unlocks
+type AssetUnlockSchedule struct {
+	AssetID string `json:"asset"`
+	// Schedule is ordered by increasing unlock time
+	Schedule []UnlockEntry `json:"schedule"`
+}
+
+// GetBalanceUnlockScheduleReply is the response from calls to
+// GetBalanceUnlockSchedule
+type GetBalanceUnlockScheduleReply struct {
+	// Assets are ordered by asset ID
+	Assets []AssetUnlockSchedule `json:"assets"`
+}
+
+// GetBalanceUnlockSchedule returns, for each asset held by [args.Address]
+// that is currently locked, the times at which the locked balance unlocks.
+// Amounts that unlock at the same time are aggregated.
+//
+// If ![args.IncludePartial], only UTXOs with a 1-out-of-1 multisig are
+// counted.
+func (s *Service) GetBalanceUnlockSchedule(_ *http.Request, args *GetBalanceUnlockScheduleArgs, reply *GetBalanceUnlockScheduleReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getBalanceUnlockSchedule"),
+		logging.UserString("address", args.Address),
+	)
+
+	address, err := avax.ParseServiceAddress(s.vm, args.Address)
+	if err != nil {
+		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
+	}
+
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	utxos, err := avax.GetAllUTXOs(s.vm.state, set.Of(address))
 	if err != nil {
-		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+		return fmt.Errorf("couldn't get address's UTXOs: %w", err)
 	}
 
 	now := s.vm.clock.Unix()
-	reply.UTXOIDs = make([]avax.UTXOID, 0, len(utxos))
+	// key: asset ID. value: amount of the asset unlocking at each locktime
+	schedules := make(map[ids.ID]map[uint64]uint64)
 	for _, utxo := range utxos {
-		if utxo.AssetID() != assetID {
-			continue
-		}
 		// TODO make this not specific to *secp256k1fx.TransferOutput
 		transferable, ok := utxo.Out.(*secp256k1fx.TransferOutput)
 		if !ok {
 			continue
 		}
 		owners := transferable.OutputOwners
-		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+		if owners.Locktime <= now {
 			continue
 		}
-		amt, err := safemath.Add(transferable.Amount(), uint64(reply.Balance))
+		if !args.IncludePartial && len(owners.Addrs) != 1 {
+			continue
+		}
+
+		assetID := utxo.AssetID()
+		schedule, ok := schedules[assetID]
+		if !ok {
+			schedule = make(map[uint64]uint64)
+			schedules[assetID] = schedule
+		}
+		amount, err := safemath.Add(transferable.Amount(), schedule[owners.Locktime])
 		if err != nil {
-			return err
+			amount = math.MaxUint64
+		}
+		schedule[owners.Locktime] = amount
+	}
+
+	assetIDs := maps.Keys(schedules)
+	utils.Sort(assetIDs)
+	reply.Assets = make([]AssetUnlockSchedule, len(assetIDs))
+	for i, assetID := range assetIDs {
+		schedule := schedules[assetID]
+		unlockTimes := maps.Keys(schedule)
+		slices.Sort(unlockTimes)
+
+		entries := make([]UnlockEntry, len(unlockTimes))
+		for j, unlockTime := range unlockTimes {
+			entries[j] = UnlockEntry{
+				UnlockTime: avajson.Uint64(unlockTime),
+				Amount:     avajson.Uint64(schedule[unlockTime]),
+			}
+		}
+		reply.Assets[i] = AssetUnlockSchedule{
+			AssetID:  s.vm.PrimaryAliasOrDefault(assetID),
+			Schedule: entries,
 		}
-		reply.Balance = avajson.Uint64(amt)
-		reply.UTXOIDs = append(reply.UTXOIDs, utxo.UTXOID)
 	}
-
 	return nil
 }
 
-type Balance struct {
-	AssetID string         `json:"asset"`
-	Balance avajson.Uint64 `json:"balance"`
+// GetNFTsArgs are the arguments for calls to GetNFTs
+type GetNFTsArgs struct {
+	api.JSONAddress
+	// Limit is the max number of UTXOs to scan. If it is 0 or above
+	// [maxPageSize], [maxPageSize] UTXOs are scanned.
+	Limit avajson.Uint32 `json:"limit"`
+	// StartIndex is the EndIndex of the previous page. If it is empty, the
+	// first page is returned.
+	StartIndex api.Index `json:"startIndex"`
 }
 
-type GetAllBalancesArgs struct {
-	api.JSONAddress
-	IncludePartial bool `json:"includePartial"`
+// NFT is an NFT held in a UTXO
+type NFT struct {
+	UTXOID string `json:"utxoID"`
+	// Payload is hex encoded
+	Payload string `json:"payload"`
 }
 
-// GetAllBalancesReply is the response from a call to GetAllBalances
-type GetAllBalancesReply struct {
-	Balances []Balance `json:"balances"`
+// NFTGroup is the NFTs held of a group of an NFT asset
+type NFTGroup struct {
+	AssetID ids.ID         `json:"assetID"`
+	GroupID avajson.Uint32 `json:"groupID"`
+	NFTs    []NFT          `json:"nfts"`
 }
 
-// GetAllBalances returns a map where:
-//
-// Key: ID of an asset such that [args.Address] has a non-zero balance of the asset
-// Value: The balance of the asset held by the address
-//
-// If ![args.IncludePartial], returns only unlocked balance/UTXOs with a 1-out-of-1 multisig.
-// Otherwise, returned balance/UTXOs includes assets held only partially by the
-// address, and includes balances with locktime in the future.
-func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, reply *GetAllBalancesReply) error {
-	s.vm.ctx.Log.Debug("deprecated API called",
+// GetNFTsReply is the response from calls to GetNFTs
+type GetNFTsReply struct {
+	// Groups are ordered by asset ID and then by group ID
+	Groups []NFTGroup `json:"groups"`
+	// NumFetched is the number of UTXOs scanned, including UTXOs that don't
+	// hold NFTs
+	NumFetched avajson.Uint64 `json:"numFetched"`
+	// EndIndex is the last UTXO scanned, to be passed as the StartIndex of the
+	// next page
+	EndIndex api.Index `json:"endIndex"`
+}
+
+// GetNFTs returns the NFTs held by [args.Address], grouped by asset and group.
+// A page of the address's UTXOs is scanned, so a page may contain fewer NFTs
+// than [args.Limit] even if more remain.
+func (s *Service) GetNFTs(_ *http.Request, args *GetNFTsArgs, reply *GetNFTsReply) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "avm"),
-		zap.String("method", "getAllBalances"),
+		zap.String("method", "getNFTs"),
 		logging.UserString("address", args.Address),
 	)
 
-	address, err := avax.ParseServiceAddress(s.vm, args.Address)
+	addr, err := avax.ParseServiceAddress(s.vm, args.Address)
 	if err != nil {
 		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
 	}
-	addrSet := set.Of(address)
+
+	startAddr := ids.ShortEmpty
+	startUTXO := ids.Empty
+	if args.StartIndex.Address != "" || args.StartIndex.UTXO != "" {
+		startAddr, err = avax.ParseServiceAddress(s.vm, args.StartIndex.Address)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index address %q: %w", args.StartIndex.Address, err)
+		}
+		startUTXO, err = ids.FromString(args.StartIndex.UTXO)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index utxo: %w", err)
+		}
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || int(maxPageSize) < limit {
+		limit = int(maxPageSize)
+	}
 
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	utxos, endAddr, endUTXOID, err := avax.GetPaginatedUTXOs(
+		s.vm.state,
+		set.Of(addr),
+		startAddr,
+		startUTXO,
+		limit,
+	)
 	if err != nil {
-		return fmt.Errorf("couldn't get address's UTXOs: %w", err)
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
 	}
 
-	now := s.vm.clock.Unix()
-	assetIDs := set.Set[ids.ID]{}       // IDs of assets the address has a non-zero balance of
-	balances := make(map[ids.ID]uint64) // key: ID (as bytes). value: balance of that asset
+	type groupKey struct {
+		assetID ids.ID
+		groupID uint32
+	}
+	groups := make(map[groupKey][]NFT)
 	for _, utxo := range utxos {
-		// TODO make this not specific to *secp256k1fx.TransferOutput
-		transferable, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		out, ok := utxo.Out.(*nftfx.TransferOutput)
 		if !ok {
 			continue
 		}
-		owners := transferable.OutputOwners
-		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
-			continue
-		}
-		assetID := utxo.AssetID()
-		assetIDs.Add(assetID)
-		balance := balances[assetID] // 0 if key doesn't exist
-		balance, err := safemath.Add(transferable.Amount(), balance)
+		payload, err := formatting.Encode(formatting.Hex, out.Payload)
 		if err != nil {
-			balances[assetID] = math.MaxUint64
-		} else {
-			balances[assetID] = balance
+			return fmt.Errorf("couldn't encode payload of UTXO %s: %w", utxo.InputID(), err)
+		}
+		key := groupKey{
+			assetID: utxo.AssetID(),
+			groupID: out.GroupID,
 		}
+		groups[key] = append(groups[key], NFT{
+			UTXOID:  utxo.InputID().String(),
+			Payload: payload,
+		})
 	}
 
-	reply.Balances = make([]Balance, assetIDs.Len())
-	i := 0
-	for assetID := range assetIDs {
-		alias := s.vm.PrimaryAliasOrDefault(assetID)
-		reply.Balances[i] = Balance{
-			AssetID: alias,
-			Balance: avajson.Uint64(balances[assetID]),
+	keys := maps.Keys(groups)
+	slices.SortFunc(keys, func(a, b groupKey) int {
+		if c := a.assetID.Compare(b.assetID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.groupID, b.groupID)
+	})
+	reply.Groups = make([]NFTGroup, len(keys))
+	for i, key := range keys {
+		reply.Groups[i] = NFTGroup{
+			AssetID: key.assetID,
+			GroupID: avajson.Uint32(key.groupID),
+			NFTs:    groups[key],
 		}
-		i++
 	}
 
+	endAddress, err := s.vm.FormatLocalAddress(endAddr)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.EndIndex.Address = endAddress
+	reply.EndIndex.UTXO = endUTXOID.String()
 	return nil
 }
 
@@ -1054,6 +2627,132 @@ func (s *Service) ListAddresses(_ *http.Request, args *api.UserPass, response *a
 	return user.Close()
 }
 
+// ListAddressesWithBalancesArgs are arguments for ListAddressesWithBalances
+type ListAddressesWithBalancesArgs struct {
+	api.UserPass
+	IncludePartial bool `json:"includePartial"`
+	// If [AllAssets] is true, the balances of all assets held by each address
+	// are returned. Otherwise, only the AVAX balance is returned.
+	AllAssets bool `json:"allAssets"`
+	// If [HRP] is non-empty, the addresses in the reply are formatted with it
+	// rather than the HRP of this network. The override only applies to this
+	// reply. Other replies, such as UTXO and tx JSON replies, always use the
+	// HRP of this network so that their addresses can be passed back to this
+	// node.
+	HRP string `json:"hrp"`
+}
+
+// AddressBalances are the balances held by an address
+type AddressBalances struct {
+	Address  string    `json:"address"`
+	Balances []Balance `json:"balances"`
+}
+
+// ListAddressesWithBalancesReply is the response from a call to
+// ListAddressesWithBalances
+type ListAddressesWithBalancesReply struct {
+	Addresses []AddressBalances `json:"addresses"`
+}
+
+// ListAddressesWithBalances returns all of the addresses controlled by user
+// [args.Username] along with their balances.
+//
+// If ![args.AllAssets], only the AVAX balance of each address is returned,
+// even if it is zero. Otherwise, the non-zero balances of all assets are
+// returned.
+//
+// If ![args.IncludePartial], returns only unlocked balances with a 1-out-of-1
+// multisig. Otherwise, returned balances include assets held only partially by
+// the address, and include balances with locktime in the future.
+func (s *Service) ListAddressesWithBalances(_ *http.Request, args *ListAddressesWithBalancesArgs, reply *ListAddressesWithBalancesReply) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "avm"),
+		zap.String("method", "listAddressesWithBalances"),
+		logging.UserString("username", args.Username),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+
+	reply.Addresses = []AddressBalances{}
+
+	addresses, err := user.GetAddresses()
+	if err != nil {
+		// An error fetching the addresses may just mean that the user has no
+		// addresses.
+		return user.Close()
+	}
+
+	for _, addr := range addresses {
+		addrStr, err := s.formatAddress(addr, args.HRP)
+		if err != nil {
+			// Drop any potential error closing the database to report the
+			// original error
+			_ = user.Close()
+			return fmt.Errorf("problem formatting address: %w", err)
+		}
+
+		allBalances, err := s.getAllBalances(addr, args.IncludePartial)
+		if err != nil {
+			_ = user.Close()
+			return err
+		}
+
+		var balances []Balance
+		if args.AllAssets {
+			balances = make([]Balance, 0, len(allBalances))
+			for assetID, balance := range allBalances {
+				balances = append(balances, Balance{
+					AssetID: s.vm.PrimaryAliasOrDefault(assetID),
+					Balance: avajson.Uint64(balance),
+				})
+			}
+		} else {
+			balances = []Balance{{
+				AssetID: s.vm.PrimaryAliasOrDefault(s.vm.feeAssetID),
+				Balance: avajson.Uint64(allBalances[s.vm.feeAssetID]),
+			}}
+		}
+
+		reply.Addresses = append(reply.Addresses, AddressBalances{
+			Address:  addrStr,
+			Balances: balances,
+		})
+	}
+	return user.Close()
+}
+
+// formatAddress formats [addr] as an address on this chain using [hrp], or the
+// HRP of this network if [hrp] is empty.
+func (s *Service) formatAddress(addr ids.ShortID, hrp string) (string, error) {
+	if hrp == "" {
+		return s.vm.FormatLocalAddress(addr)
+	}
+
+	// Bech32 encoding lowercases the HRP, so mixed case must be rejected here.
+	if strings.ToLower(hrp) != hrp && strings.ToUpper(hrp) != hrp {
+		return "", fmt.Errorf("%w %q: mixed case", errInvalidHRP, hrp)
+	}
+	chainIDAlias, err := s.vm.ctx.BCLookup.PrimaryAlias(s.vm.ctx.ChainID)
+	if err != nil {
+		return "", err
+	}
+	addrStr, err := address.Format(chainIDAlias, hrp, addr.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %w", errInvalidHRP, hrp, err)
+	}
+	// Encoding doesn't validate the HRP, so make sure the result can be parsed.
+	if _, _, _, err := address.Parse(addrStr); err != nil {
+		return "", fmt.Errorf("%w %q: %w", errInvalidHRP, hrp, err)
+	}
+	return addrStr, nil
+}
+
 // ExportKeyArgs are arguments for ExportKey
 type ExportKeyArgs struct {
 	api.UserPass
@@ -1097,6 +2796,72 @@ func (s *Service) ExportKey(_ *http.Request, args *ExportKeyArgs, reply *ExportK
 	return user.Close()
 }
 
+// ExportedKey is a private key along with the address it controls
+type ExportedKey struct {
+	Address    string                `json:"address"`
+	PrivateKey *secp256k1.PrivateKey `json:"privateKey"`
+}
+
+// ExportAllKeysReply is the response for ExportAllKeys
+type ExportAllKeysReply struct {
+	Keys []ExportedKey `json:"keys"`
+}
+
+// ExportAllKeys returns every private key of the provided user. It is only
+// available if the export-all-keys-enabled config is set.
+func (s *Service) ExportAllKeys(_ *http.Request, args *api.UserPass, reply *ExportAllKeysReply) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "avm"),
+		zap.String("method", "exportAllKeys"),
+		logging.UserString("username", args.Username),
+	)
+
+	if !s.vm.exportAllKeysEnabled {
+		return errBulkExportDisabled
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+
+	addresses, err := user.GetAddresses()
+	if err != nil {
+		_ = user.Close()
+		return fmt.Errorf("problem retrieving addresses: %w", err)
+	}
+
+	reply.Keys = make([]ExportedKey, len(addresses))
+	for i, address := range addresses {
+		addr, err := s.vm.FormatLocalAddress(address)
+		if err != nil {
+			_ = user.Close()
+			return fmt.Errorf("problem formatting address: %w", err)
+		}
+
+		sk, err := user.GetKey(address)
+		if err != nil {
+			_ = user.Close()
+			return fmt.Errorf("problem retrieving private key: %w", err)
+		}
+
+		reply.Keys[i] = ExportedKey{
+			Address:    addr,
+			PrivateKey: sk,
+		}
+	}
+
+	s.vm.ctx.Log.Info("exported all keys",
+		zap.String("service", "avm"),
+		logging.UserString("username", args.Username),
+		zap.Int("numKeys", len(reply.Keys)),
+	)
+	return user.Close()
+}
+
 // ImportKeyArgs are arguments for ImportKey
 type ImportKeyArgs struct {
 	api.UserPass
@@ -1134,27 +2899,80 @@ func (s *Service) ImportKey(_ *http.Request, args *ImportKeyArgs, reply *api.JSO
 		return fmt.Errorf("problem saving key %w", err)
 	}
 
-	newAddress := args.PrivateKey.PublicKey().Address()
-	reply.Address, err = s.vm.FormatLocalAddress(newAddress)
+	newAddress := args.PrivateKey.PublicKey().Address()
+	reply.Address, err = s.vm.FormatLocalAddress(newAddress)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+
+	return user.Close()
+}
+
+// SendOutput specifies that [Amount] of asset [AssetID] be sent to [To]
+type SendOutput struct {
+	// The amount of funds to send
+	Amount avajson.Uint64 `json:"amount"`
+
+	// ID of the asset being sent
+	AssetID string `json:"assetID"`
+
+	// Address of the recipient
+	To string `json:"to"`
+}
+
+// GetChangeAddressArgs are arguments for passing into GetChangeAddress
+// requests
+type GetChangeAddressArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+}
+
+// GetChangeAddress returns the address that would receive the change of a tx
+// funded by [args.From]. This is [args.ChangeAddr] if it is set, and otherwise
+// the address of the first key the user controls in [args.From], which is the
+// same change address Send and the other keystore methods would select.
+func (s *Service) GetChangeAddress(_ *http.Request, args *GetChangeAddressArgs, reply *api.JSONChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getChangeAddress"),
+		logging.UserString("username", args.Username),
+	)
+
+	fromAddrs, err := avax.ParseServiceAddresses(s.vm, args.From)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	// Drop any potential error closing the database to report the original
+	// error
+	defer user.Close()
+
+	kc, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return err
+	}
+	if len(kc.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr, err := s.vm.selectChangeAddr(kc.Keys[0].PublicKey().Address(), args.ChangeAddr)
+	if err != nil {
+		return err
+	}
+
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
 	if err != nil {
 		return fmt.Errorf("problem formatting address: %w", err)
 	}
-
 	return user.Close()
 }
 
-// SendOutput specifies that [Amount] of asset [AssetID] be sent to [To]
-type SendOutput struct {
-	// The amount of funds to send
-	Amount avajson.Uint64 `json:"amount"`
-
-	// ID of the asset being sent
-	AssetID string `json:"assetID"`
-
-	// Address of the recipient
-	To string `json:"to"`
-}
-
 // SendArgs are arguments for passing into Send requests
 type SendArgs struct {
 	// User, password, from addrs, change addr
@@ -1212,18 +3030,28 @@ func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *a
 }
 
 func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortID, error) {
+	tx, keys, changeAddr, err := s.buildUnsignedSendMultiple(args)
+	if err != nil {
+		return nil, ids.ShortEmpty, err
+	}
+	return tx, changeAddr, tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys)
+}
+
+// buildUnsignedSendMultiple returns the unsigned tx described by [args] along
+// with the keys that must sign each of its inputs.
+func (s *Service) buildUnsignedSendMultiple(args *SendMultipleArgs) (*txs.Tx, [][]*secp256k1.PrivateKey, ids.ShortID, error) {
 	// Validate the memo field
 	memoBytes := []byte(args.Memo)
 	if l := len(memoBytes); l > avax.MaxMemoSize {
-		return nil, ids.ShortEmpty, fmt.Errorf("max memo length is %d but provided memo field is length %d", avax.MaxMemoSize, l)
+		return nil, nil, ids.ShortEmpty, fmt.Errorf("max memo length is %d but provided memo field is length %d", avax.MaxMemoSize, l)
 	} else if len(args.Outputs) == 0 {
-		return nil, ids.ShortEmpty, errNoOutputs
+		return nil, nil, ids.ShortEmpty, errNoOutputs
 	}
 
 	// Parse the from addresses
 	fromAddrs, err := avax.ParseServiceAddresses(s.vm, args.From)
 	if err != nil {
-		return nil, ids.ShortEmpty, err
+		return nil, nil, ids.ShortEmpty, err
 	}
 
 	s.vm.ctx.Lock.Lock()
@@ -1232,18 +3060,40 @@ func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortI
 	// Load user's UTXOs/keys
 	utxos, kc, err := s.vm.LoadUser(args.Username, args.Password, fromAddrs)
 	if err != nil {
-		return nil, ids.ShortEmpty, err
+		return nil, nil, ids.ShortEmpty, err
 	}
 
 	// Parse the change address.
 	if len(kc.Keys) == 0 {
-		return nil, ids.ShortEmpty, errNoKeys
+		return nil, nil, ids.ShortEmpty, errNoKeys
 	}
 	changeAddr, err := s.vm.selectChangeAddr(kc.Keys[0].PublicKey().Address(), args.ChangeAddr)
 	if err != nil {
-		return nil, ids.ShortEmpty, err
+		return nil, nil, ids.ShortEmpty, err
 	}
 
+	tx, keys, err := s.buildUnsignedSend(
+		args.Outputs,
+		memoBytes,
+		changeAddr,
+		func(amounts map[ids.ID]uint64) (map[ids.ID]uint64, []*avax.TransferableInput, [][]*secp256k1.PrivateKey, error) {
+			return s.vm.Spend(utxos, kc, amounts)
+		},
+	)
+	return tx, keys, changeAddr, err
+}
+
+// buildUnsignedSend returns the unsigned tx that sends [outputs], funded by
+// the inputs returned by [spend], along with the keys that must sign each of
+// its inputs. Any excess of the spent amounts is returned to [changeAddr].
+//
+// Invariant: The context lock is held.
+func (s *Service) buildUnsignedSend(
+	outputs []SendOutput,
+	memoBytes []byte,
+	changeAddr ids.ShortID,
+	spend func(amounts map[ids.ID]uint64) (map[ids.ID]uint64, []*avax.TransferableInput, [][]*secp256k1.PrivateKey, error),
+) (*txs.Tx, [][]*secp256k1.PrivateKey, error) {
 	// Calculate required input amounts and create the desired outputs
 	// String repr. of asset ID --> asset ID
 	assetIDs := make(map[string]ids.ID)
@@ -1251,29 +3101,30 @@ func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortI
 	amounts := make(map[ids.ID]uint64)
 	// Outputs of our tx
 	outs := []*avax.TransferableOutput{}
-	for _, output := range args.Outputs {
+	for _, output := range outputs {
 		if output.Amount == 0 {
-			return nil, ids.ShortEmpty, errZeroAmount
+			return nil, nil, errZeroAmount
 		}
 		assetID, ok := assetIDs[output.AssetID] // Asset ID of next output
 		if !ok {
+			var err error
 			assetID, err = s.vm.lookupAssetID(output.AssetID)
 			if err != nil {
-				return nil, ids.ShortEmpty, fmt.Errorf("couldn't find asset %s", output.AssetID)
+				return nil, nil, fmt.Errorf("couldn't find asset %s", output.AssetID)
 			}
 			assetIDs[output.AssetID] = assetID
 		}
 		currentAmount := amounts[assetID]
 		newAmount, err := safemath.Add(currentAmount, uint64(output.Amount))
 		if err != nil {
-			return nil, ids.ShortEmpty, fmt.Errorf("problem calculating required spend amount: %w", err)
+			return nil, nil, fmt.Errorf("problem calculating required spend amount: %w", err)
 		}
 		amounts[assetID] = newAmount
 
 		// Parse the to address
 		to, err := avax.ParseServiceAddress(s.vm, output.To)
 		if err != nil {
-			return nil, ids.ShortEmpty, fmt.Errorf("problem parsing to address %q: %w", output.To, err)
+			return nil, nil, fmt.Errorf("problem parsing to address %q: %w", output.To, err)
 		}
 
 		// Create the Output
@@ -1297,17 +3148,13 @@ func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortI
 
 	amountWithFee, err := safemath.Add(amounts[s.vm.feeAssetID], s.vm.TxFee)
 	if err != nil {
-		return nil, ids.ShortEmpty, fmt.Errorf("problem calculating required spend amount: %w", err)
+		return nil, nil, fmt.Errorf("problem calculating required spend amount: %w", err)
 	}
 	amountsWithFee[s.vm.feeAssetID] = amountWithFee
 
-	amountsSpent, ins, keys, err := s.vm.Spend(
-		utxos,
-		kc,
-		amountsWithFee,
-	)
+	amountsSpent, ins, keys, err := spend(amountsWithFee)
 	if err != nil {
-		return nil, ids.ShortEmpty, err
+		return nil, nil, err
 	}
 
 	// Add the required change outputs
@@ -1329,8 +3176,7 @@ func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortI
 		}
 	}
 
-	codec := s.vm.parser.Codec()
-	avax.SortTransferableOutputs(outs, codec)
+	avax.SortTransferableOutputs(outs, s.vm.parser.Codec())
 
 	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
 		NetworkID:    s.vm.ctx.NetworkID,
@@ -1339,7 +3185,387 @@ func (s *Service) buildSendMultiple(args *SendMultipleArgs) (*txs.Tx, ids.ShortI
 		Ins:          ins,
 		Memo:         memoBytes,
 	}}}
-	return tx, changeAddr, tx.SignSECP256K1Fx(codec, keys)
+	return tx, keys, nil
+}
+
+// EstimateTxSizeArgs are arguments for passing into EstimateTxSize requests
+type EstimateTxSizeArgs struct {
+	SendMultipleArgs
+}
+
+// EstimateTxSizeReply defines the EstimateTxSize replies returned from the API
+type EstimateTxSizeReply struct {
+	// Size is the number of bytes of the signed tx
+	Size avajson.Uint64 `json:"size"`
+}
+
+// EstimateTxSize returns the size of the tx that SendMultiple would issue for
+// [args], without signing or issuing it.
+func (s *Service) EstimateTxSize(_ *http.Request, args *EstimateTxSizeArgs, reply *EstimateTxSizeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "estimateTxSize"),
+		logging.UserString("username", args.Username),
+	)
+
+	tx, keys, _, err := s.buildUnsignedSendMultiple(&args.SendMultipleArgs)
+	if err != nil {
+		return err
+	}
+
+	// Signatures have a fixed length, so placeholder credentials with the
+	// same number of signatures serialize to the same size as the real ones.
+	for _, inputKeys := range keys {
+		tx.Creds = append(tx.Creds, &fxs.FxCredential{
+			Credential: &secp256k1fx.Credential{
+				Sigs: make([][secp256k1.SignatureLen]byte, len(inputKeys)),
+			},
+		})
+	}
+
+	txBytes, err := s.vm.parser.Codec().Marshal(txs.CodecVersion, tx)
+	if err != nil {
+		return fmt.Errorf("problem serializing transaction: %w", err)
+	}
+	reply.Size = avajson.Uint64(len(txBytes))
+	return nil
+}
+
+// SimulateSendArgs are arguments for passing into SimulateSend requests
+type SimulateSendArgs struct {
+	// The addresses whose UTXOs fund the send
+	api.JSONFromAddrs
+
+	// The address that receives the change. If empty, the first from address
+	// is used.
+	api.JSONChangeAddr
+
+	// The amount, assetID, and destination to send funds to
+	SendOutput
+
+	// Memo field
+	Memo string `json:"memo"`
+}
+
+// SimulateSendReply defines the SimulateSend replies returned from the API
+type SimulateSendReply struct {
+	// Consumed are the JSON representations of the UTXOs the tx would consume
+	Consumed []json.RawMessage `json:"consumed"`
+	// Created are the JSON representations of the outputs the tx would
+	// create, including change, ordered by output index. The IDs of the
+	// created UTXOs depend on the tx's signatures, so they aren't known.
+	Created []json.RawMessage `json:"created"`
+	// ChangeAddr is the address that would receive the change
+	ChangeAddr string `json:"changeAddr"`
+}
+
+// SimulateSend builds the tx that would send [args.Amount] of [args.AssetID]
+// from [args.From] at the current state, and returns the UTXOs it would
+// consume and create. The tx is neither signed nor issued, so no keys are
+// required.
+func (s *Service) SimulateSend(_ *http.Request, args *SimulateSendArgs, reply *SimulateSendReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "simulateSend"),
+		logging.UserString("assetID", args.AssetID),
+		zap.Int("numFromAddresses", len(args.From)),
+	)
+
+	memoBytes := []byte(args.Memo)
+	if l := len(memoBytes); l > avax.MaxMemoSize {
+		return fmt.Errorf("max memo length is %d but provided memo field is length %d", avax.MaxMemoSize, l)
+	}
+	if len(args.From) == 0 {
+		return errNoAddresses
+	}
+
+	fromAddrs, err := avax.ParseServiceAddresses(s.vm, args.From)
+	if err != nil {
+		return err
+	}
+	defaultChangeAddr, err := avax.ParseServiceAddress(s.vm, args.From[0])
+	if err != nil {
+		return err
+	}
+	changeAddr, err := s.vm.selectChangeAddr(defaultChangeAddr, args.ChangeAddr)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses' UTXOs: %w", err)
+	}
+
+	tx, _, err := s.buildUnsignedSend(
+		[]SendOutput{args.SendOutput},
+		memoBytes,
+		changeAddr,
+		func(amounts map[ids.ID]uint64) (map[ids.ID]uint64, []*avax.TransferableInput, [][]*secp256k1.PrivateKey, error) {
+			amountsSpent, ins, err := s.spendWithoutKeys(utxos, fromAddrs, amounts)
+			return amountsSpent, ins, nil, err
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Unsigned.Visit(&txInit{
+		tx:            tx,
+		ctx:           s.vm.ctx,
+		typeToFxIndex: s.vm.typeToFxIndex,
+		fxs:           s.vm.fxs,
+	})
+	if err != nil {
+		return err
+	}
+
+	utxosByID := make(map[ids.ID]*avax.UTXO, len(utxos))
+	for _, utxo := range utxos {
+		utxosByID[utxo.InputID()] = utxo
+	}
+	baseTx := tx.Unsigned.(*txs.BaseTx)
+	reply.Consumed = make([]json.RawMessage, len(baseTx.Ins))
+	for i, in := range baseTx.Ins {
+		utxo := utxosByID[in.InputID()]
+		if out, ok := utxo.Out.(avax.TransferableOut); ok {
+			out.InitCtx(s.vm.ctx)
+		}
+		reply.Consumed[i], err = json.Marshal(utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't marshal UTXO %s: %w", in.InputID(), err)
+		}
+	}
+	reply.Created = make([]json.RawMessage, len(baseTx.Outs))
+	for i, out := range baseTx.Outs {
+		reply.Created[i], err = json.Marshal(out)
+		if err != nil {
+			return fmt.Errorf("couldn't marshal output %d: %w", i, err)
+		}
+	}
+
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	return err
+}
+
+// spendWithoutKeys selects the inputs from [utxos] that [addrs] can spend to
+// fund [amounts]. Unlike Spend, no keys are needed, so the inputs can't be
+// signed, but the consumed UTXOs are the same.
+//
+// Invariant: The context lock is held.
+func (s *Service) spendWithoutKeys(
+	utxos []*avax.UTXO,
+	addrs set.Set[ids.ShortID],
+	amounts map[ids.ID]uint64,
+) (map[ids.ID]uint64, []*avax.TransferableInput, error) {
+	var (
+		now          = s.vm.clock.Unix()
+		amountsSpent = make(map[ids.ID]uint64, len(amounts))
+		ins          []*avax.TransferableInput
+	)
+	for _, utxo := range utxos {
+		assetID := utxo.AssetID()
+		if amountsSpent[assetID] >= amounts[assetID] {
+			continue
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || !isSpendable(utxo, addrs, now) {
+			continue
+		}
+
+		sigIndices := make([]uint32, 0, out.Threshold)
+		for i, addr := range out.Addrs {
+			if uint32(len(sigIndices)) == out.Threshold {
+				break
+			}
+			if addrs.Contains(addr) {
+				sigIndices = append(sigIndices, uint32(i))
+			}
+		}
+
+		amountSpent, err := safemath.Add(amountsSpent[assetID], out.Amt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("problem calculating spent amount: %w", err)
+		}
+		amountsSpent[assetID] = amountSpent
+
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  avax.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: out.Amt,
+				Input: secp256k1fx.Input{
+					SigIndices: sigIndices,
+				},
+			},
+		})
+	}
+
+	for assetID, amount := range amounts {
+		if amountsSpent[assetID] < amount {
+			return nil, nil, fmt.Errorf("want to spend %d of asset %s but only have %d",
+				amount,
+				assetID,
+				amountsSpent[assetID],
+			)
+		}
+	}
+
+	utils.Sort(ins)
+	return amountsSpent, ins, nil
+}
+
+// SuggestConsolidationArgs are arguments for passing into
+// SuggestConsolidation requests
+type SuggestConsolidationArgs struct {
+	api.JSONAddresses
+	AssetID string `json:"assetID"`
+	// MaxAmount is the largest UTXO amount considered for consolidation. If
+	// zero, UTXOs worth less than the tx fee are considered. Must be non-zero
+	// if [AssetID] isn't the fee asset.
+	MaxAmount avajson.Uint64 `json:"maxAmount"`
+}
+
+// SuggestConsolidationReply defines the SuggestConsolidation replies returned
+// from the API
+type SuggestConsolidationReply struct {
+	// UTXOIDs are the UTXOs to consume into a single output
+	UTXOIDs []string `json:"utxoIDs"`
+	// Amount is the total amount held by [UTXOIDs]
+	Amount avajson.Uint64 `json:"amount"`
+	// Fee is the fee burned by the consolidating tx
+	Fee avajson.Uint64 `json:"fee"`
+}
+
+// SuggestConsolidation returns a set of small, spendable UTXOs held by
+// [args.Addresses] that are worth consolidating into a single output. Each
+// UTXO consumed by a tx increases its size, so replacing many small UTXOs with
+// one keeps future txs small. UTXOs of the fee asset are only suggested if
+// their total exceeds the fee of the consolidating tx. UTXOs of other assets
+// are only suggested if [args.Addresses] can pay the fee. If there is nothing
+// worth consolidating, no UTXOs are returned.
+func (s *Service) SuggestConsolidation(_ *http.Request, args *SuggestConsolidationArgs, reply *SuggestConsolidationReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "suggestConsolidation"),
+		logging.UserString("assetID", args.AssetID),
+		zap.Int("numAddresses", len(args.Addresses)),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	// The tx fee is denominated in the fee asset, so it is only a meaningful
+	// default threshold for UTXOs of the fee asset.
+	isFeeAsset := assetID == s.vm.feeAssetID
+	maxAmount := uint64(args.MaxAmount)
+	if maxAmount == 0 {
+		if !isFeeAsset {
+			return errMissingMaxAmount
+		}
+		maxAmount = s.vm.TxFee
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses' UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	var (
+		candidates = make([]*avax.UTXO, 0, len(utxos))
+		// feeBalance is the amount of the fee asset that [addrSet] is able to
+		// spend without consuming [candidates].
+		feeBalance uint64
+	)
+	for _, utxo := range utxos {
+		// TODO make this not specific to *secp256k1fx.TransferOutput
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || out.Locktime > now {
+			continue
+		}
+
+		// Only consider UTXOs that [addrSet] is able to spend.
+		numOwned := 0
+		for _, addr := range out.Addrs {
+			if addrSet.Contains(addr) {
+				numOwned++
+			}
+		}
+		if uint32(numOwned) < out.Threshold {
+			continue
+		}
+
+		switch utxoAssetID := utxo.AssetID(); {
+		case utxoAssetID == assetID && out.Amt < maxAmount:
+			candidates = append(candidates, utxo)
+		case utxoAssetID == s.vm.feeAssetID:
+			feeBalance, err = safemath.Add(feeBalance, out.Amt)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Consolidate the smallest UTXOs first.
+	slices.SortFunc(candidates, func(a, b *avax.UTXO) int {
+		return cmp.Compare(
+			a.Out.(*secp256k1fx.TransferOutput).Amt,
+			b.Out.(*secp256k1fx.TransferOutput).Amt,
+		)
+	})
+	if len(candidates) > maxConsolidationInputs {
+		candidates = candidates[:maxConsolidationInputs]
+	}
+
+	var amount uint64
+	for _, utxo := range candidates {
+		amount, err = safemath.Add(amount, utxo.Out.(*secp256k1fx.TransferOutput).Amt)
+		if err != nil {
+			return err
+		}
+	}
+
+	reply.UTXOIDs = []string{}
+	reply.Fee = avajson.Uint64(s.vm.TxFee)
+	// Consolidating a single UTXO, or UTXOs that can't cover the fee, doesn't
+	// reduce the number of UTXOs held.
+	if len(candidates) < 2 || (isFeeAsset && amount <= s.vm.TxFee) {
+		return nil
+	}
+	// Consolidating UTXOs of other assets requires the fee to be paid
+	// separately.
+	if !isFeeAsset && feeBalance < s.vm.TxFee {
+		return fmt.Errorf("%w: have %d, need %d", errInsufficientFee, feeBalance, s.vm.TxFee)
+	}
+
+	reply.UTXOIDs = make([]string, len(candidates))
+	for i, utxo := range candidates {
+		reply.UTXOIDs[i] = utxo.InputID().String()
+	}
+	reply.Amount = avajson.Uint64(amount)
+	return nil
 }
 
 // MintArgs are arguments for passing into Mint requests
@@ -1739,6 +3965,10 @@ type ImportArgs struct {
 
 	// Address receiving the imported AVAX
 	To string `json:"to"`
+
+	// If provided, only these UTXOs are imported. Each of them must be
+	// present in shared memory and spendable by the user.
+	UTXOIDs []avax.UTXOID `json:"utxoIDs"`
 }
 
 // Import imports an asset to this chain from the P/C-Chain.
@@ -1784,15 +4014,20 @@ func (s *Service) buildImport(args *ImportArgs) (*txs.Tx, error) {
 		return nil, err
 	}
 
-	atomicUTXOs, _, _, err := avax.GetAtomicUTXOs(
-		s.vm.ctx.SharedMemory,
-		s.vm.parser.Codec(),
-		chainID,
-		kc.Addrs,
-		ids.ShortEmpty,
-		ids.Empty,
-		int(maxPageSize),
-	)
+	var atomicUTXOs []*avax.UTXO
+	if len(args.UTXOIDs) > 0 {
+		atomicUTXOs, err = s.getAtomicUTXOs(chainID, args.UTXOIDs)
+	} else {
+		atomicUTXOs, _, _, err = avax.GetAtomicUTXOs(
+			s.vm.ctx.SharedMemory,
+			s.vm.parser.Codec(),
+			chainID,
+			kc.Addrs,
+			ids.ShortEmpty,
+			ids.Empty,
+			int(maxPageSize),
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("problem retrieving user's atomic UTXOs: %w", err)
 	}
@@ -1801,6 +4036,13 @@ func (s *Service) buildImport(args *ImportArgs) (*txs.Tx, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(args.UTXOIDs) > 0 {
+		// SpendAll skips the UTXOs that the user can't spend, but every listed
+		// UTXO must be imported.
+		if err := verifyAllSpent(atomicUTXOs, importInputs); err != nil {
+			return nil, err
+		}
+	}
 
 	ins := []*avax.TransferableInput{}
 	keys := [][]*secp256k1.PrivateKey{}
@@ -1863,6 +4105,51 @@ func (s *Service) buildImport(args *ImportArgs) (*txs.Tx, error) {
 	return tx, tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys)
 }
 
+// getAtomicUTXOs returns the UTXOs with the IDs in [utxoIDs] that were sent
+// from [chainID], in the order of [utxoIDs]. An error is returned if any of the
+// IDs isn't in shared memory or is listed more than once.
+func (s *Service) getAtomicUTXOs(chainID ids.ID, utxoIDs []avax.UTXOID) ([]*avax.UTXO, error) {
+	inputIDs := set.NewSet[ids.ID](len(utxoIDs))
+	utxos := make([]*avax.UTXO, len(utxoIDs))
+	for i := range utxoIDs {
+		utxoID := &utxoIDs[i]
+		inputID := utxoID.InputID()
+		if inputIDs.Contains(inputID) {
+			return nil, fmt.Errorf("%w: %s", errDuplicateUTXO, utxoID)
+		}
+		inputIDs.Add(inputID)
+
+		utxosBytes, err := s.vm.ctx.SharedMemory.Get(chainID, [][]byte{inputID[:]})
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", errMissingAtomicUTXO, utxoID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		utxo := &avax.UTXO{}
+		if _, err := s.vm.parser.Codec().Unmarshal(utxosBytes[0], utxo); err != nil {
+			return nil, fmt.Errorf("problem parsing atomic UTXO %s: %w", utxoID, err)
+		}
+		utxos[i] = utxo
+	}
+	return utxos, nil
+}
+
+// verifyAllSpent returns an error if any of [utxos] isn't consumed by [ins].
+func verifyAllSpent(utxos []*avax.UTXO, ins []*avax.TransferableInput) error {
+	inputIDs := set.NewSet[ids.ID](len(ins))
+	for _, in := range ins {
+		inputIDs.Add(in.InputID())
+	}
+	for _, utxo := range utxos {
+		if !inputIDs.Contains(utxo.InputID()) {
+			return fmt.Errorf("%w: %s", errUnspendableUTXO, &utxo.UTXOID)
+		}
+	}
+	return nil
+}
+
 // ExportArgs are arguments for passing into ExportAVA requests
 type ExportArgs struct {
 	// User, password, from addrs, change addr
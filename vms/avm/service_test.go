@@ -4,8 +4,16 @@
 package avm
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,12 +35,14 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/crypto/secp256k1"
 	"github.com/CaiJiJi/avalanchego/utils/formatting"
 	"github.com/CaiJiJi/avalanchego/utils/formatting/address"
+	"github.com/CaiJiJi/avalanchego/utils/hashing"
 	"github.com/CaiJiJi/avalanchego/utils/logging"
 	"github.com/CaiJiJi/avalanchego/utils/timer/mockable"
 	"github.com/CaiJiJi/avalanchego/utils/units"
 	"github.com/CaiJiJi/avalanchego/vms/avm/block"
 	"github.com/CaiJiJi/avalanchego/vms/avm/block/executor"
 	"github.com/CaiJiJi/avalanchego/vms/avm/config"
+	"github.com/CaiJiJi/avalanchego/vms/avm/network"
 	"github.com/CaiJiJi/avalanchego/vms/avm/state"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
@@ -41,8 +51,11 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/nftfx"
 	"github.com/CaiJiJi/avalanchego/vms/propertyfx"
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
+	"github.com/CaiJiJi/avalanchego/vms/txs/mempool"
 
 	avajson "github.com/CaiJiJi/avalanchego/utils/json"
+	blockbuilder "github.com/CaiJiJi/avalanchego/vms/avm/block/builder"
+	xmempool "github.com/CaiJiJi/avalanchego/vms/avm/txs/mempool"
 )
 
 func TestServiceIssueTx(t *testing.T) {
@@ -68,6 +81,536 @@ func TestServiceIssueTx(t *testing.T) {
 	require.Equal(tx.ID(), txReply.TxID)
 }
 
+func TestServiceIssueTxTooLarge(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.MaxTxSize = units.KiB
+	env := setup(t, &envConfig{
+		fork:            latest,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	// The bytes don't start with a valid codec version, so they would fail to
+	// parse if they were passed to the codec.
+	txBytes := bytes.Repeat([]byte{0xff}, units.KiB+1)
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	require.NoError(err)
+	txArgs := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+
+	err = service.IssueTx(nil, txArgs, &api.JSONTxID{})
+	require.ErrorIs(err, errTxTooLarge)
+
+	err = service.DecodeTx(nil, txArgs, &api.GetTxReply{})
+	require.ErrorIs(err, errTxTooLarge)
+
+	// Txs at the limit are parsed.
+	txArgs.Tx, err = formatting.Encode(formatting.Hex, txBytes[:units.KiB])
+	require.NoError(err)
+	err = service.IssueTx(nil, txArgs, &api.JSONTxID{})
+	require.ErrorIs(err, codec.ErrUnknownVersion)
+}
+
+func TestServiceIssueTxFromParts(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	// Only the unsigned tx is used; its credentials are signed below as if
+	// they had been signed offline.
+	expectedTx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	unsignedBytes := expectedTx.Unsigned.Bytes()
+	sig, err := keys[0].SignHash(hashing.ComputeHash256(unsignedBytes))
+	require.NoError(err)
+
+	args := &IssueTxFromPartsArgs{
+		Encoding: formatting.Hex,
+	}
+	args.UnsignedTx, err = formatting.Encode(formatting.Hex, unsignedBytes)
+	require.NoError(err)
+	sigStr, err := formatting.Encode(formatting.Hex, sig)
+	require.NoError(err)
+
+	reply := &api.JSONTxID{}
+	err = service.IssueTxFromParts(nil, args, reply)
+	require.ErrorIs(err, errWrongNumCreds)
+
+	args.Signatures = [][]string{{sigStr}}
+	require.NoError(service.IssueTxFromParts(nil, args, reply))
+	require.Equal(expectedTx.ID(), reply.TxID)
+
+	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
+
+	env.vm.ctx.Lock.Lock()
+	defer env.vm.ctx.Lock.Unlock()
+
+	tx, err := env.vm.state.GetTx(reply.TxID)
+	require.NoError(err)
+	require.Equal(expectedTx.Bytes(), tx.Bytes())
+}
+
+func TestNewCreds(t *testing.T) {
+	require := require.New(t)
+
+	ins := []*avax.TransferableInput{{
+		In: &secp256k1fx.TransferInput{},
+	}}
+	creds, err := newCreds(&txs.OperationTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Ins: ins,
+		}},
+		Ops: []*txs.Operation{
+			{Op: &secp256k1fx.MintOperation{}},
+			{Op: &nftfx.TransferOperation{}},
+			{Op: &propertyfx.BurnOperation{}},
+		},
+	})
+	require.NoError(err)
+	require.Equal([]verify.Verifiable{
+		&secp256k1fx.Credential{},
+		&secp256k1fx.Credential{},
+		&nftfx.Credential{},
+		&propertyfx.Credential{},
+	}, creds)
+
+	creds, err = newCreds(&txs.ImportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Ins: ins,
+		}},
+		ImportedIns: ins,
+	})
+	require.NoError(err)
+	require.Len(creds, 2)
+
+	// Inputs of other fxs can't be signed.
+	ctrl := gomock.NewController(t)
+	_, err = newCreds(&txs.BaseTx{BaseTx: avax.BaseTx{
+		Ins: []*avax.TransferableInput{{
+			In: avax.NewMockTransferableIn(ctrl),
+		}},
+	}})
+	require.ErrorIs(err, errUnsupportedInputType)
+}
+
+func TestServiceIssueTxWithRetry(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	// Malformed txs are never retried.
+	txArgs := &IssueTxWithRetryArgs{}
+	txReply := &api.JSONTxID{}
+	err := service.IssueTxWithRetry(&http.Request{}, txArgs, txReply)
+	require.ErrorIs(err, codec.ErrCantUnpackVersion)
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	txArgs.Tx, err = formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	txArgs.Encoding = formatting.Hex
+	txReply = &api.JSONTxID{}
+	require.NoError(service.IssueTxWithRetry(&http.Request{}, txArgs, txReply))
+	require.Equal(tx.ID(), txReply.TxID)
+}
+
+func TestServiceReplaceTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	// newTxWithFee returns a tx that spends the same genesis UTXO as [newTx]
+	// and burns [fee].
+	newTxWithFee := func(fee uint64) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: env.vm.ctx.ChainID,
+				Ins: []*avax.TransferableInput{{
+					UTXOID: avax.UTXOID{
+						TxID:        env.genesisTx.ID(),
+						OutputIndex: 2,
+					},
+					Asset: avax.Asset{ID: env.genesisTx.ID()},
+					In: &secp256k1fx.TransferInput{
+						Amt: startBalance,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0},
+						},
+					},
+				}},
+				Outs: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: env.genesisTx.ID()},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: startBalance - fee,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{keys[0].Address()},
+						},
+					},
+				}},
+			},
+		}}
+		require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{keys[0]}}))
+		return tx
+	}
+	replaceTx := func(tx *txs.Tx) (ids.ID, error) {
+		txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+		require.NoError(err)
+
+		reply := &api.JSONTxID{}
+		err = service.ReplaceTx(nil, &ReplaceTxArgs{
+			FormattedTx: api.FormattedTx{
+				Tx:       txStr,
+				Encoding: formatting.Hex,
+			},
+		}, reply)
+		return reply.TxID, err
+	}
+
+	lowFeeTx := newTxWithFee(testTxFee)
+	_, err := replaceTx(lowFeeTx)
+	require.ErrorIs(err, network.ErrNoConflictingTx)
+
+	txID, err := env.vm.issueTxFromRPC(lowFeeTx)
+	require.NoError(err)
+	require.Equal(lowFeeTx.ID(), txID)
+
+	// A fee bump below the configured minimum doesn't replace the tx.
+	_, err = replaceTx(newTxWithFee(testTxFee + testTxFee/20))
+	require.ErrorIs(err, errInsufficientFeeBump)
+
+	highFeeTx := newTxWithFee(2 * testTxFee)
+	txID, err = replaceTx(highFeeTx)
+	require.NoError(err)
+	require.Equal(highFeeTx.ID(), txID)
+
+	// Only the replacement tx is left to be included in a block.
+	buildAndAccept(require, env.vm, env.issuer, highFeeTx.ID())
+}
+
+func TestServiceGetTxsSpendingUTXO(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	utxoID := avax.UTXOID{
+		TxID:        env.genesisTx.ID(),
+		OutputIndex: 2,
+	}
+	newTxWithFee := func(fee uint64) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: env.vm.ctx.ChainID,
+				Ins: []*avax.TransferableInput{{
+					UTXOID: utxoID,
+					Asset:  avax.Asset{ID: env.genesisTx.ID()},
+					In: &secp256k1fx.TransferInput{
+						Amt: startBalance,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0},
+						},
+					},
+				}},
+				Outs: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: env.genesisTx.ID()},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: startBalance - fee,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{keys[0].Address()},
+						},
+					},
+				}},
+			},
+		}}
+		require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{keys[0]}}))
+		return tx
+	}
+	getTxsSpendingUTXO := func() []ids.ID {
+		reply := &GetTxsSpendingUTXOReply{}
+		require.NoError(service.GetTxsSpendingUTXO(nil, &GetTxsSpendingUTXOArgs{
+			UTXOID: utxoID,
+		}, reply))
+		return reply.TxIDs
+	}
+
+	require.Empty(getTxsSpendingUTXO())
+
+	tx := newTxWithFee(testTxFee)
+	_, err := env.vm.issueTxFromRPC(tx)
+	require.NoError(err)
+	require.Equal([]ids.ID{tx.ID()}, getTxsSpendingUTXO())
+
+	// A conflicting tx isn't added to the mempool, so only the first spender
+	// is reported.
+	_, err = env.vm.issueTxFromRPC(newTxWithFee(2 * testTxFee))
+	require.ErrorIs(err, mempool.ErrConflictsWithOtherTx)
+	require.Equal([]ids.ID{tx.ID()}, getTxsSpendingUTXO())
+
+	// Once the spender is accepted, it is no longer pending.
+	buildAndAccept(require, env.vm, env.issuer, tx.ID())
+	require.Empty(getTxsSpendingUTXO())
+}
+
+func TestServiceGetTxsByMemo(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexMemos = true
+	env := setup(t, &envConfig{
+		fork:            latest,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	service := &Service{vm: env.vm}
+
+	var (
+		key = keys[0]
+		kc  = secp256k1fx.NewKeychain(key)
+	)
+	tx, err := env.txBuilder.BaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: env.vm.feeAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MicroAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.PublicKey().Address()},
+				},
+			},
+		}},
+		[]byte("invoice-42"),
+		kc,
+		key.PublicKey().Address(),
+	)
+	require.NoError(err)
+	env.vm.ctx.Lock.Unlock()
+
+	issueAndAccept(require, env.vm, env.issuer, tx)
+
+	tests := []struct {
+		name          string
+		memo          string
+		prefix        bool
+		expectedTxIDs []ids.ID
+	}{
+		{
+			name:          "exact match",
+			memo:          "invoice-42",
+			expectedTxIDs: []ids.ID{tx.ID()},
+		},
+		{
+			name:          "prefix match",
+			memo:          "invoice-",
+			prefix:        true,
+			expectedTxIDs: []ids.ID{tx.ID()},
+		},
+		{
+			name: "prefix without prefix matching",
+			memo: "invoice-",
+		},
+		{
+			name:   "longer than memo",
+			memo:   "invoice-420",
+			prefix: true,
+		},
+	}
+	for _, test := range tests {
+		reply := &GetAddressTxsReply{}
+		require.NoError(service.GetTxsByMemo(nil, &GetTxsByMemoArgs{
+			Memo:   test.memo,
+			Prefix: test.prefix,
+		}, reply), test.name)
+		require.Equal(test.expectedTxIDs, reply.TxIDs, test.name)
+		require.Equal(avajson.Uint64(len(test.expectedTxIDs)), reply.Cursor, test.name)
+	}
+
+	err = service.GetTxsByMemo(nil, &GetTxsByMemoArgs{}, &GetAddressTxsReply{})
+	require.ErrorIs(err, errNoMemo)
+}
+
+func TestServiceGetTxInputUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	genesisUTXOID := avax.UTXOID{
+		TxID:        env.genesisTx.ID(),
+		OutputIndex: 2,
+	}
+	prunedUTXOID := avax.UTXOID{
+		TxID: ids.GenerateTestID(),
+	}
+	newInput := func(utxoID avax.UTXOID) *avax.TransferableInput {
+		return &avax.TransferableInput{
+			UTXOID: utxoID,
+			Asset:  avax.Asset{ID: env.genesisTx.ID()},
+			In: &secp256k1fx.TransferInput{
+				Amt: startBalance,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}
+	}
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: env.vm.ctx.ChainID,
+			Ins: []*avax.TransferableInput{
+				newInput(genesisUTXOID),
+				newInput(prunedUTXOID),
+			},
+		},
+	}}
+	require.NoError(tx.Initialize(env.vm.parser.Codec()))
+
+	env.vm.ctx.Lock.Lock()
+	env.vm.state.AddTx(tx)
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	reply := &GetTxInputUTXOsReply{}
+	require.NoError(service.GetTxInputUTXOs(nil, &api.JSONTxID{
+		TxID: tx.ID(),
+	}, reply))
+	require.Len(reply.UTXOs, 2)
+
+	// The genesis UTXO is rebuilt from the genesis tx.
+	genesisUTXO := reply.UTXOs[0]
+	require.Equal(genesisUTXOID, genesisUTXO.UTXOID)
+	require.False(genesisUTXO.Pruned)
+
+	var utxo struct {
+		AssetID ids.ID `json:"assetID"`
+		Output  struct {
+			Amount    uint64   `json:"amount"`
+			Addresses []string `json:"addresses"`
+		} `json:"output"`
+	}
+	require.NoError(json.Unmarshal(genesisUTXO.UTXO, &utxo))
+	require.Equal(env.genesisTx.ID(), utxo.AssetID)
+	require.Equal(startBalance, utxo.Output.Amount)
+	require.Len(utxo.Output.Addresses, 1)
+
+	// The source tx of the other input isn't known.
+	require.Equal(TxInputUTXO{
+		UTXOID: prunedUTXOID,
+		Pruned: true,
+	}, reply.UTXOs[1])
+}
+
+func TestIssueTxWithRetry(t *testing.T) {
+	errInvalidTx := errors.New("invalid tx")
+
+	tests := []struct {
+		name          string
+		cancelled     bool
+		maxRetries    uint32
+		backoff       time.Duration
+		results       []error
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "accepted",
+			maxRetries:    3,
+			results:       []error{nil},
+			expectedCalls: 1,
+		},
+		{
+			name:       "accepted after transient rejection",
+			maxRetries: 3,
+			results: []error{
+				mempool.ErrMempoolFull,
+				nil,
+			},
+			expectedCalls: 2,
+		},
+		{
+			name:       "permanent rejection",
+			maxRetries: 3,
+			results: []error{
+				errInvalidTx,
+				nil,
+			},
+			expectedErr:   errInvalidTx,
+			expectedCalls: 1,
+		},
+		{
+			name:       "retries exhausted",
+			maxRetries: 2,
+			results: []error{
+				mempool.ErrMempoolFull,
+				mempool.ErrMempoolFull,
+				mempool.ErrMempoolFull,
+				nil,
+			},
+			expectedErr:   mempool.ErrMempoolFull,
+			expectedCalls: 3,
+		},
+		{
+			name:       "cancelled",
+			cancelled:  true,
+			maxRetries: 3,
+			backoff:    time.Hour,
+			results: []error{
+				mempool.ErrMempoolFull,
+				nil,
+			},
+			expectedErr:   context.Canceled,
+			expectedCalls: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelled {
+				cancel()
+			}
+
+			// issue stubs out adding a tx to the mempool.
+			calls := 0
+			issue := func() error {
+				err := test.results[calls]
+				calls++
+				return err
+			}
+
+			err := issueTxWithRetry(ctx, issue, test.maxRetries, test.backoff)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedCalls, calls)
+		})
+	}
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	require := require.New(t)
 
@@ -97,6 +640,44 @@ func TestServiceGetTxStatus(t *testing.T) {
 	statusReply = &GetTxStatusReply{}
 	require.NoError(service.GetTxStatus(nil, statusArgs, statusReply))
 	require.Equal(choices.Accepted, statusReply.Status)
+	require.Equal(avajson.Uint64(1), statusReply.Confirmations)
+
+	// Accepting more blocks should increase the number of confirmations. The
+	// first tx sent its change to keys[1], so the following txs are funded by
+	// that key.
+	changeKey := keys[1]
+	for i := 2; i <= 3; i++ {
+		tx, err := env.txBuilder.BaseTx(
+			[]*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: env.vm.feeAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: units.MicroAvax,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{changeKey.Address()},
+					},
+				},
+			}},
+			nil,
+			secp256k1fx.NewKeychain(changeKey),
+			changeKey.Address(),
+		)
+		require.NoError(err)
+		issueAndAccept(require, env.vm, env.issuer, tx)
+
+		statusReply = &GetTxStatusReply{}
+		require.NoError(service.GetTxStatus(nil, statusArgs, statusReply))
+		require.Equal(choices.Accepted, statusReply.Status)
+		require.Equal(avajson.Uint64(i), statusReply.Confirmations)
+	}
+
+	// The genesis tx wasn't accepted in a block.
+	statusReply = &GetTxStatusReply{}
+	require.NoError(service.GetTxStatus(nil, &api.JSONTxID{
+		TxID: env.genesisTx.ID(),
+	}, statusReply))
+	require.Equal(choices.Accepted, statusReply.Status)
+	require.Zero(statusReply.Confirmations)
 }
 
 // Test the GetBalance method when argument Strict is true
@@ -295,33 +876,95 @@ func TestServiceGetTxs(t *testing.T) {
 	require.Equal(getTxsReply.TxIDs, testTxs[10:20])
 }
 
-func TestServiceGetAllBalances(t *testing.T) {
+func TestServiceGetMultiAddressTxs(t *testing.T) {
 	require := require.New(t)
-
 	env := setup(t, &envConfig{
 		fork: latest,
 	})
 	service := &Service{vm: env.vm}
 
-	assetID := ids.GenerateTestID()
-	addr := ids.GenerateTestShortID()
-	addrStr, err := env.vm.FormatLocalAddress(addr)
+	var err error
+	env.vm.addressTxsIndexer, err = index.NewIndexer(env.vm.db, env.vm.ctx.Log, "", prometheus.NewRegistry(), false)
 	require.NoError(err)
-	// A UTXO with a 2 out of 2 multisig
-	// where one of the addresses is [addr]
-	twoOfTwoUTXO := &avax.UTXO{
-		UTXOID: avax.UTXOID{
-			TxID:        ids.GenerateTestID(),
-			OutputIndex: 0,
-		},
-		Asset: avax.Asset{ID: assetID},
-		Out: &secp256k1fx.TransferOutput{
-			Amt: 1337,
-			OutputOwners: secp256k1fx.OutputOwners{
-				Threshold: 2,
-				Addrs:     []ids.ShortID{addr, ids.GenerateTestShortID()},
-			},
-		},
+
+	var (
+		assetID = ids.GenerateTestID()
+		addr0   = ids.ShortID{1}
+		addr1   = ids.ShortID{2}
+
+		addr0Txs = []ids.ID{{0x01}, {0x02}, {0x03}}
+		// The second tx is shared with [addr0], so it is only listed once.
+		addr1Txs = []ids.ID{{0x04}, {0x02}, {0x05}}
+	)
+	putTestTxIndex(t, env.vm.db, addr0, assetID, addr0Txs)
+	putTestTxIndex(t, env.vm.db, addr1, assetID, addr1Txs)
+
+	addr0Str, err := env.vm.FormatLocalAddress(addr0)
+	require.NoError(err)
+	addr1Str, err := env.vm.FormatLocalAddress(addr1)
+	require.NoError(err)
+
+	env.vm.ctx.Lock.Unlock()
+
+	// The merge order doesn't depend on the order the addresses are given in.
+	args := &GetMultiAddressTxsArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{addr1Str, addr0Str}},
+		AssetID:       assetID.String(),
+	}
+	reply := &GetAddressTxsReply{}
+	require.NoError(service.GetMultiAddressTxs(nil, args, reply))
+	expectedTxs := []ids.ID{{0x01}, {0x02}, {0x03}, {0x04}, {0x05}}
+	require.Equal(expectedTxs, reply.TxIDs)
+	require.Equal(avajson.Uint64(len(expectedTxs)), reply.Cursor)
+
+	// Page through the merged list.
+	args.PageSize = 2
+	var pagedTxs []ids.ID
+	for {
+		reply := &GetAddressTxsReply{}
+		require.NoError(service.GetMultiAddressTxs(nil, args, reply))
+		if len(reply.TxIDs) == 0 {
+			break
+		}
+		require.LessOrEqual(len(reply.TxIDs), 2)
+		pagedTxs = append(pagedTxs, reply.TxIDs...)
+		args.Cursor = reply.Cursor
+	}
+	require.Equal(expectedTxs, pagedTxs)
+
+	err = service.GetMultiAddressTxs(nil, &GetMultiAddressTxsArgs{
+		AssetID: assetID.String(),
+	}, &GetAddressTxsReply{})
+	require.ErrorIs(err, errNoAddresses)
+}
+
+func TestServiceGetAllBalances(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+
+	assetID := ids.GenerateTestID()
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+	// A UTXO with a 2 out of 2 multisig
+	// where one of the addresses is [addr]
+	twoOfTwoUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1337,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, ids.GenerateTestShortID()},
+			},
+		},
 	}
 	// Insert the UTXO
 	env.vm.state.AddUTXO(twoOfTwoUTXO)
@@ -491,6 +1134,292 @@ func TestServiceGetAllBalances(t *testing.T) {
 	require.Empty(reply.Balances)
 }
 
+func TestServiceSuggestConsolidation(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	assetID := env.genesisTx.ID()
+	now := uint64(env.vm.clock.Time().Unix())
+	addUTXO := func(addr ids.ShortID, assetID ids.ID, amount uint64, locktime uint64) ids.ID {
+		utxo := &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  locktime,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		}
+		env.vm.state.AddUTXO(utxo)
+		return utxo.InputID()
+	}
+
+	const numDust = 10
+	var (
+		dustUTXOIDs = make([]string, numDust)
+		dustAmount  uint64
+	)
+	for i := range dustUTXOIDs {
+		amount := uint64(numDust-i) * testTxFee / (numDust + 1)
+		dustUTXOIDs[numDust-1-i] = addUTXO(addr, assetID, amount, 0).String()
+		dustAmount += amount
+	}
+	addUTXO(addr, assetID, testTxFee, 0) // not dust
+	addUTXO(addr, assetID, 1, now+100)   // locked
+
+	// UTXOs of a different asset, held by both [addr] and [noFeeAddr]. Only
+	// [addr] is able to pay the fee.
+	otherAssetID := ids.GenerateTestID()
+	noFeeAddr := ids.GenerateTestShortID()
+	noFeeAddrStr, err := env.vm.FormatLocalAddress(noFeeAddr)
+	require.NoError(err)
+	otherUTXOIDs := make([]string, 2)
+	for i := range otherUTXOIDs {
+		otherUTXOIDs[i] = addUTXO(addr, otherAssetID, 1, 0).String()
+		addUTXO(noFeeAddr, otherAssetID, 1, 0)
+	}
+	slices.Sort(otherUTXOIDs)
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	reply := &SuggestConsolidationReply{}
+	require.NoError(service.SuggestConsolidation(nil, &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{addrStr}},
+		AssetID:       assetID.String(),
+	}, reply))
+	require.NotEmpty(reply.UTXOIDs)
+	require.Len(reply.UTXOIDs, numDust)
+	require.Equal(dustUTXOIDs, reply.UTXOIDs)
+	require.Equal(avajson.Uint64(dustAmount), reply.Amount)
+	require.Equal(avajson.Uint64(testTxFee), reply.Fee)
+
+	// Dust that isn't worth more than the fee isn't suggested.
+	reply = &SuggestConsolidationReply{}
+	require.NoError(service.SuggestConsolidation(nil, &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{addrStr}},
+		AssetID:       assetID.String(),
+		MaxAmount:     avajson.Uint64(3 * testTxFee / (numDust + 1)),
+	}, reply))
+	require.Empty(reply.UTXOIDs)
+
+	// The fee doesn't apply to the amount of other assets, so a threshold must
+	// be given.
+	err = service.SuggestConsolidation(nil, &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{addrStr}},
+		AssetID:       otherAssetID.String(),
+	}, &SuggestConsolidationReply{})
+	require.ErrorIs(err, errMissingMaxAmount)
+
+	reply = &SuggestConsolidationReply{}
+	require.NoError(service.SuggestConsolidation(nil, &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{addrStr}},
+		AssetID:       otherAssetID.String(),
+		MaxAmount:     2,
+	}, reply))
+	replyUTXOIDs := slices.Clone(reply.UTXOIDs)
+	slices.Sort(replyUTXOIDs)
+	require.Equal(otherUTXOIDs, replyUTXOIDs)
+	require.Equal(avajson.Uint64(2), reply.Amount)
+
+	// The fee must be payable by the addresses.
+	err = service.SuggestConsolidation(nil, &SuggestConsolidationArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: []string{noFeeAddrStr}},
+		AssetID:       otherAssetID.String(),
+		MaxAmount:     2,
+	}, &SuggestConsolidationReply{})
+	require.ErrorIs(err, errInsufficientFee)
+}
+
+func TestServiceGetBalanceUnlockSchedule(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	now := uint64(env.vm.clock.Time().Unix())
+	var (
+		assetID0 = ids.ID{1}
+		assetID1 = ids.ID{2}
+	)
+	addUTXO := func(assetID ids.ID, amount uint64, locktime uint64, addrs ...ids.ShortID) {
+		env.vm.state.AddUTXO(&avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  locktime,
+					Threshold: 1,
+					Addrs:     addrs,
+				},
+			},
+		})
+	}
+	addUTXO(assetID1, 1, now+200, addr)
+	addUTXO(assetID0, 2, now+200, addr)
+	addUTXO(assetID0, 3, now+100, addr)
+	addUTXO(assetID0, 4, now+200, addr) // aggregated with the other UTXO unlocking at now+200
+	addUTXO(assetID0, 5, now, addr)     // already unlocked
+	addUTXO(assetID0, 6, now+300, addr, ids.GenerateTestShortID())
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	reply := &GetBalanceUnlockScheduleReply{}
+	require.NoError(service.GetBalanceUnlockSchedule(nil, &GetBalanceUnlockScheduleArgs{
+		JSONAddress: api.JSONAddress{Address: addrStr},
+	}, reply))
+	require.Equal(
+		[]AssetUnlockSchedule{
+			{
+				AssetID: assetID0.String(),
+				Schedule: []UnlockEntry{
+					{UnlockTime: avajson.Uint64(now + 100), Amount: 3},
+					{UnlockTime: avajson.Uint64(now + 200), Amount: 6},
+				},
+			},
+			{
+				AssetID: assetID1.String(),
+				Schedule: []UnlockEntry{
+					{UnlockTime: avajson.Uint64(now + 200), Amount: 1},
+				},
+			},
+		},
+		reply.Assets,
+	)
+
+	// The partially owned UTXO is included when requested.
+	reply = &GetBalanceUnlockScheduleReply{}
+	require.NoError(service.GetBalanceUnlockSchedule(nil, &GetBalanceUnlockScheduleArgs{
+		JSONAddress:    api.JSONAddress{Address: addrStr},
+		IncludePartial: true,
+	}, reply))
+	require.Len(reply.Assets, 2)
+	require.Equal(
+		[]UnlockEntry{
+			{UnlockTime: avajson.Uint64(now + 100), Amount: 3},
+			{UnlockTime: avajson.Uint64(now + 200), Amount: 6},
+			{UnlockTime: avajson.Uint64(now + 300), Amount: 6},
+		},
+		reply.Assets[0].Schedule,
+	)
+}
+
+func TestServiceGetNFTs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+	assetID := ids.GenerateTestID()
+	addUTXO := func(out verify.State) *avax.UTXO {
+		utxo := &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out:   out,
+		}
+		env.vm.state.AddUTXO(utxo)
+		return utxo
+	}
+	utxo0 := addUTXO(&nftfx.TransferOutput{
+		GroupID:      0,
+		Payload:      []byte{0},
+		OutputOwners: owners,
+	})
+	utxo1 := addUTXO(&nftfx.TransferOutput{
+		GroupID:      1,
+		Payload:      []byte{1},
+		OutputOwners: owners,
+	})
+	addUTXO(&secp256k1fx.TransferOutput{
+		Amt:          1,
+		OutputOwners: owners,
+	})
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	nft := func(utxo *avax.UTXO) NFT {
+		out := utxo.Out.(*nftfx.TransferOutput)
+		payload, err := formatting.Encode(formatting.Hex, out.Payload)
+		require.NoError(err)
+		return NFT{
+			UTXOID:  utxo.InputID().String(),
+			Payload: payload,
+		}
+	}
+	expectedGroups := []NFTGroup{
+		{
+			AssetID: assetID,
+			GroupID: 0,
+			NFTs:    []NFT{nft(utxo0)},
+		},
+		{
+			AssetID: assetID,
+			GroupID: 1,
+			NFTs:    []NFT{nft(utxo1)},
+		},
+	}
+
+	reply := &GetNFTsReply{}
+	require.NoError(service.GetNFTs(nil, &GetNFTsArgs{
+		JSONAddress: api.JSONAddress{Address: addrStr},
+	}, reply))
+	require.Equal(expectedGroups, reply.Groups)
+	require.Equal(avajson.Uint64(3), reply.NumFetched)
+
+	// Paging through the UTXOs one at a time should find the same NFTs.
+	var (
+		groups     []NFTGroup
+		startIndex api.Index
+	)
+	for {
+		reply := &GetNFTsReply{}
+		require.NoError(service.GetNFTs(nil, &GetNFTsArgs{
+			JSONAddress: api.JSONAddress{Address: addrStr},
+			Limit:       1,
+			StartIndex:  startIndex,
+		}, reply))
+		if reply.NumFetched == 0 {
+			break
+		}
+		groups = append(groups, reply.Groups...)
+		startIndex = reply.EndIndex
+	}
+	require.ElementsMatch(expectedGroups, groups)
+}
+
 func TestServiceGetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -516,6 +1445,46 @@ func TestServiceGetTx(t *testing.T) {
 	require.Equal(env.genesisTx.Bytes(), txBytes)
 }
 
+func TestServiceDecodeTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	decodeReply := api.GetTxReply{}
+	err := service.DecodeTx(nil, &api.FormattedTx{}, &decodeReply)
+	require.ErrorIs(err, codec.ErrCantUnpackVersion)
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	txStr, err := formatting.Encode(formatting.Hex, newTx.Bytes())
+	require.NoError(err)
+
+	decodeReply = api.GetTxReply{}
+	require.NoError(service.DecodeTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &decodeReply))
+	require.Equal(formatting.JSON, decodeReply.Encoding)
+
+	// Decoding the tx doesn't issue it.
+	env.vm.ctx.Lock.Lock()
+	_, err = env.vm.state.GetTx(newTx.ID())
+	env.vm.ctx.Lock.Unlock()
+	require.ErrorIs(err, database.ErrNotFound)
+
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	getReply := api.GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:     newTx.ID(),
+		Encoding: formatting.JSON,
+	}, &getReply))
+	require.JSONEq(string(getReply.Tx), string(decodeReply.Tx))
+}
+
 func TestServiceGetTxJSON_BaseTx(t *testing.T) {
 	require := require.New(t)
 
@@ -604,6 +1573,88 @@ func TestServiceGetTxJSON_BaseTx(t *testing.T) {
 	require.Equal(expectedReplyTxString, string(replyTxBytes))
 }
 
+func TestServiceGetTxJSON_OmitCredentials(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	reply := api.GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:            newTx.ID(),
+		Encoding:        formatting.JSON,
+		OmitCredentials: true,
+	}, &reply))
+
+	require.Equal(formatting.JSON, reply.Encoding)
+
+	var replyTx map[string]json.RawMessage
+	require.NoError(json.Unmarshal(reply.Tx, &replyTx))
+	require.Len(replyTx, 2)
+	require.Contains(replyTx, "unsignedTx")
+	require.NotContains(replyTx, "credentials")
+
+	var txID ids.ID
+	require.NoError(json.Unmarshal(replyTx["id"], &txID))
+	require.Equal(newTx.ID(), txID)
+}
+
+func TestServiceGetTxBlockContext(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	env.vm.ctx.Lock.Lock()
+	blkID := env.vm.state.GetLastAccepted()
+	blk, err := env.vm.state.GetBlock(blkID)
+	env.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+	require.Len(blk.Txs(), 1)
+	require.Equal(newTx.ID(), blk.Txs()[0].ID())
+
+	// The block context should only be included if requested.
+	reply := api.GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:     newTx.ID(),
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Nil(reply.BlockContext)
+
+	reply = api.GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:                newTx.ID(),
+		Encoding:            formatting.Hex,
+		IncludeBlockContext: true,
+	}, &reply))
+	require.Equal(&api.TxBlockContext{
+		BlockID:   blkID,
+		Height:    avajson.Uint64(blk.Height()),
+		Timestamp: avajson.Uint64(blk.Timestamp().Unix()),
+	}, reply.BlockContext)
+
+	// The genesis tx wasn't accepted in a block.
+	reply = api.GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:                env.genesisTx.ID(),
+		Encoding:            formatting.Hex,
+		IncludeBlockContext: true,
+	}, &reply))
+	require.Nil(reply.BlockContext)
+}
+
 func TestServiceGetTxJSON_ExportTx(t *testing.T) {
 	require := require.New(t)
 
@@ -1854,25 +2905,82 @@ func TestServiceGetTxJSON_OperationTxWithPropertyFxMintOpMultiple(t *testing.T)
 	require.Equal(expectedReplyTxString, string(replyTxBytes))
 }
 
-func newAvaxBaseTxWithOutputs(t *testing.T, env *environment) *txs.Tx {
-	var (
-		memo      = []byte{1, 2, 3, 4, 5, 6, 7, 8}
-		key       = keys[0]
-		changeKey = keys[1]
-		kc        = secp256k1fx.NewKeychain(key)
-	)
+// amountRegex matches the amounts of the inputs and outputs in a JSON
+// formatted tx.
+var amountRegex = regexp.MustCompile(`("amount":\s*)\d+`)
 
-	tx, err := env.txBuilder.BaseTx(
-		[]*avax.TransferableOutput{{
-			Asset: avax.Asset{ID: env.vm.feeAssetID},
-			Out: &secp256k1fx.TransferOutput{
-				Amt: units.MicroAvax,
-				OutputOwners: secp256k1fx.OutputOwners{
-					Threshold: 1,
-					Addrs:     []ids.ShortID{key.PublicKey().Address()},
-				},
-			},
-		}},
+// maskAmounts replaces the amounts of the inputs and outputs in the JSON
+// formatted [tx] with a placeholder. This allows asserting the structure of a
+// tx without depending on the fees that were paid by it.
+func maskAmounts(tx string) string {
+	return amountRegex.ReplaceAllString(tx, `${1}"<amount>"`)
+}
+
+func TestMaskAmounts(t *testing.T) {
+	tx := `{
+	"outputs": [
+		{
+			"output": {
+				"amount": 48000,
+				"locktime": 0,
+				"threshold": 1
+			}
+		}
+	],
+	"inputs": [
+		{
+			"input": {
+				"amount": 50000,
+				"signatureIndices": [
+					0
+				]
+			}
+		}
+	]
+}`
+	expectedTx := `{
+	"outputs": [
+		{
+			"output": {
+				"amount": "<amount>",
+				"locktime": 0,
+				"threshold": 1
+			}
+		}
+	],
+	"inputs": [
+		{
+			"input": {
+				"amount": "<amount>",
+				"signatureIndices": [
+					0
+				]
+			}
+		}
+	]
+}`
+	require.Equal(t, expectedTx, maskAmounts(tx))
+}
+
+func newAvaxBaseTxWithOutputs(t *testing.T, env *environment) *txs.Tx {
+	var (
+		memo      = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+		key       = keys[0]
+		changeKey = keys[1]
+		kc        = secp256k1fx.NewKeychain(key)
+	)
+
+	tx, err := env.txBuilder.BaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: env.vm.feeAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MicroAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.PublicKey().Address()},
+				},
+			},
+		}},
 		memo,
 		kc,
 		changeKey.PublicKey().Address(),
@@ -2284,6 +3392,287 @@ func TestServiceGetUTXOs(t *testing.T) {
 	}
 }
 
+func TestServiceGetUTXO(t *testing.T) {
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	newUTXO := func() *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.GenerateTestID(),
+				OutputIndex: 1,
+			},
+			Asset: avax.Asset{ID: env.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}
+	}
+
+	codec := env.vm.parser.Codec()
+	localUTXO := newUTXO()
+	localUTXOBytes, err := codec.Marshal(txs.CodecVersion, localUTXO)
+	require.NoError(t, err)
+
+	env.vm.ctx.Lock.Lock()
+	env.vm.state.AddUTXO(localUTXO)
+	require.NoError(t, env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	atomicUTXO := newUTXO()
+	atomicUTXOBytes, err := codec.Marshal(txs.CodecVersion, atomicUTXO)
+	require.NoError(t, err)
+
+	atomicUTXOID := atomicUTXO.InputID()
+	sm := env.sharedMemory.NewSharedMemory(constants.PlatformChainID)
+	require.NoError(t, sm.Apply(map[ids.ID]*atomic.Requests{
+		env.vm.ctx.ChainID: {
+			PutRequests: []*atomic.Element{{
+				Key:   atomicUTXOID[:],
+				Value: atomicUTXOBytes,
+			}},
+		},
+	}))
+
+	tests := []struct {
+		name          string
+		args          *GetUTXOArgs
+		expectedBytes []byte
+		expectedErr   error
+	}{
+		{
+			name: "local UTXO",
+			args: &GetUTXOArgs{
+				UTXOID:   localUTXO.UTXOID,
+				Encoding: formatting.Hex,
+			},
+			expectedBytes: localUTXOBytes,
+		},
+		{
+			name: "atomic UTXO",
+			args: &GetUTXOArgs{
+				UTXOID:      atomicUTXO.UTXOID,
+				SourceChain: "P",
+				Encoding:    formatting.Hex,
+			},
+			expectedBytes: atomicUTXOBytes,
+		},
+		{
+			name: "atomic UTXO looked up locally",
+			args: &GetUTXOArgs{
+				UTXOID:   atomicUTXO.UTXOID,
+				Encoding: formatting.Hex,
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name: "unknown UTXO",
+			args: &GetUTXOArgs{
+				UTXOID: avax.UTXOID{
+					TxID: ids.GenerateTestID(),
+				},
+				Encoding: formatting.Hex,
+			},
+			expectedErr: database.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			reply := &GetUTXOReply{}
+			err := service.GetUTXO(nil, test.args, reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(formatting.Hex, reply.Encoding)
+			utxoBytes, err := formatting.Decode(reply.Encoding, reply.UTXO)
+			require.NoError(err)
+			require.Equal(test.expectedBytes, utxoBytes)
+		})
+	}
+}
+
+func TestServiceGetUTXOsSpendableOnly(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	addr := ids.GenerateTestShortID()
+	otherAddr := ids.GenerateTestShortID()
+	newUTXO := func(owners secp256k1fx.OutputOwners) *avax.UTXO {
+		owners.Sort()
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: env.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: owners,
+			},
+		}
+	}
+	var (
+		spendableUTXO = newUTXO(secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		})
+		lockedUTXO = newUTXO(secp256k1fx.OutputOwners{
+			Locktime:  math.MaxUint64,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		})
+		multisigUTXO = newUTXO(secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{addr, otherAddr},
+		})
+	)
+
+	env.vm.ctx.Lock.Lock()
+	env.vm.state.AddUTXO(spendableUTXO)
+	env.vm.state.AddUTXO(lockedUTXO)
+	env.vm.state.AddUTXO(multisigUTXO)
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	xAddr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+	xOtherAddr, err := env.vm.FormatLocalAddress(otherAddr)
+	require.NoError(err)
+
+	getUTXOIDs := func(args *api.GetUTXOsArgs) []ids.ID {
+		args.Encoding = formatting.Hex
+		reply := &api.GetUTXOsReply{}
+		require.NoError(service.GetUTXOs(nil, args, reply))
+		require.Equal(avajson.Uint64(len(reply.UTXOs)), reply.NumFetched)
+
+		utxoIDs := make([]ids.ID, len(reply.UTXOs))
+		for i, utxoStr := range reply.UTXOs {
+			utxoBytes, err := formatting.Decode(formatting.Hex, utxoStr)
+			require.NoError(err)
+			utxo := &avax.UTXO{}
+			_, err = env.vm.parser.Codec().Unmarshal(utxoBytes, utxo)
+			require.NoError(err)
+			utxoIDs[i] = utxo.InputID()
+		}
+		return utxoIDs
+	}
+
+	// All UTXOs are returned by default.
+	require.ElementsMatch(
+		[]ids.ID{
+			spendableUTXO.InputID(),
+			lockedUTXO.InputID(),
+			multisigUTXO.InputID(),
+		},
+		getUTXOIDs(&api.GetUTXOsArgs{
+			Addresses: []string{xAddr},
+		}),
+	)
+
+	// The locked and multisig UTXOs aren't spendable by [addr] alone.
+	require.Equal(
+		[]ids.ID{
+			spendableUTXO.InputID(),
+		},
+		getUTXOIDs(&api.GetUTXOsArgs{
+			Addresses:     []string{xAddr},
+			SpendableOnly: true,
+		}),
+	)
+
+	// The multisig UTXO is spendable by [addr] and [otherAddr] together.
+	require.ElementsMatch(
+		[]ids.ID{
+			spendableUTXO.InputID(),
+			multisigUTXO.InputID(),
+		},
+		getUTXOIDs(&api.GetUTXOsArgs{
+			Addresses:     []string{xAddr, xOtherAddr},
+			SpendableOnly: true,
+		}),
+	)
+}
+
+func TestServiceGetUTXOsTruncatedByDeadline(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	// The deadline passes immediately, so every call returns a single UTXO.
+	env.vm.getUTXOsDeadline = time.Nanosecond
+
+	addr := ids.GenerateTestShortID()
+	expectedUTXOIDs := make([]ids.ID, 3)
+	for i := range expectedUTXOIDs {
+		utxo := &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: env.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		}
+		env.vm.state.AddUTXO(utxo)
+		expectedUTXOIDs[i] = utxo.InputID()
+	}
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	xAddr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	var (
+		utxoIDs    []ids.ID
+		startIndex api.Index
+	)
+	for {
+		reply := &api.GetUTXOsReply{}
+		require.NoError(service.GetUTXOs(nil, &api.GetUTXOsArgs{
+			Addresses:  []string{xAddr},
+			StartIndex: startIndex,
+			Encoding:   formatting.Hex,
+		}, reply))
+		if !reply.TruncatedByDeadline {
+			require.Empty(reply.UTXOs)
+			break
+		}
+		require.Len(reply.UTXOs, 1)
+
+		utxoBytes, err := formatting.Decode(formatting.Hex, reply.UTXOs[0])
+		require.NoError(err)
+		utxo := &avax.UTXO{}
+		_, err = env.vm.parser.Codec().Unmarshal(utxoBytes, utxo)
+		require.NoError(err)
+		utxoIDs = append(utxoIDs, utxo.InputID())
+
+		// Continue the scan from where the truncated call stopped.
+		startIndex = reply.EndIndex
+	}
+	require.ElementsMatch(expectedUTXOIDs, utxoIDs)
+}
+
 func TestGetAssetDescription(t *testing.T) {
 	require := require.New(t)
 
@@ -2304,7 +3693,7 @@ func TestGetAssetDescription(t *testing.T) {
 	require.Equal("SYMB", reply.Symbol)
 }
 
-func TestGetBalance(t *testing.T) {
+func TestServiceGetGenesisAssets(t *testing.T) {
 	require := require.New(t)
 
 	env := setup(t, &envConfig{
@@ -2313,66 +3702,254 @@ func TestGetBalance(t *testing.T) {
 	service := &Service{vm: env.vm}
 	env.vm.ctx.Lock.Unlock()
 
-	avaxAssetID := env.genesisTx.ID()
-
-	reply := GetBalanceReply{}
-	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
-	require.NoError(err)
-	require.NoError(service.GetBalance(nil, &GetBalanceArgs{
-		Address: addrStr,
-		AssetID: avaxAssetID.String(),
-	}, &reply))
+	reply := GetGenesisAssetsReply{}
+	require.NoError(service.GetGenesisAssets(nil, nil, &reply))
+	require.Len(reply.Assets, len(env.vm.genesisTxs))
 
-	require.Equal(startBalance, uint64(reply.Balance))
+	avaxAssetID := env.genesisTx.ID()
+	i := slices.IndexFunc(reply.Assets, func(asset GenesisAssetDescription) bool {
+		return asset.AssetID == avaxAssetID
+	})
+	require.GreaterOrEqual(i, 0)
+
+	avaxAsset := reply.Assets[i]
+	require.Equal("AVAX", avaxAsset.Name)
+	require.Equal("SYMB", avaxAsset.Symbol)
+	require.Equal("asset1", avaxAsset.Alias)
+	require.Len(avaxAsset.InitialHolders, len(addrs))
+	for _, holder := range avaxAsset.InitialHolders {
+		require.Equal(avajson.Uint64(startBalance), holder.Amount)
+		require.Equal(avajson.Uint32(1), holder.Threshold)
+		require.Len(holder.Addresses, 1)
+	}
 }
 
-func TestCreateFixedCapAsset(t *testing.T) {
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			require := require.New(t)
+func TestGetAssets(t *testing.T) {
+	require := require.New(t)
 
-			env := setup(t, &envConfig{
-				isCustomFeeAsset: !tc.avaxAsset,
-				keystoreUsers: []*user{{
-					username:    username,
-					password:    password,
-					initialKeys: keys,
-				}},
-			})
-			service := &Service{vm: env.vm}
-			env.vm.ctx.Lock.Unlock()
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
 
-			reply := AssetIDChangeAddr{}
-			addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
-			require.NoError(err)
+	var (
+		key = keys[0]
+		kc  = secp256k1fx.NewKeychain(key)
+	)
 
-			changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
-			require.NoError(err)
-			_, fromAddrsStr := sampleAddrs(t, env.vm.AddressManager, addrs)
+	// The genesis assets are indexed during initialization
+	genesisReply := GetAssetsReply{}
+	require.NoError(service.GetAssets(nil, &GetAssetsArgs{}, &genesisReply))
+	require.Contains(genesisReply.Assets, GetAssetDescriptionReply{
+		FormattedAssetID: FormattedAssetID{
+			AssetID: env.genesisTx.ID(),
+		},
+		Name:         "AVAX",
+		Symbol:       "SYMB",
+		Denomination: 0,
+	})
 
-			require.NoError(service.CreateFixedCapAsset(nil, &CreateAssetArgs{
-				JSONSpendHeader: api.JSONSpendHeader{
-					UserPass: api.UserPass{
-						Username: username,
-						Password: password,
+	expectedAssets := genesisReply.Assets
+	for i, symbol := range []string{"TRA", "TRB", "TRC"} {
+		tx, err := env.txBuilder.CreateAssetTx(
+			"Team Rocket "+symbol, // name
+			symbol,                // symbol
+			byte(i),               // denomination
+			map[uint32][]verify.State{
+				0: {
+					&secp256k1fx.TransferOutput{
+						Amt: 1,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{key.Address()},
+						},
 					},
-					JSONFromAddrs:  api.JSONFromAddrs{From: fromAddrsStr},
-					JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
 				},
-				Name:         "testAsset",
-				Symbol:       "TEST",
-				Denomination: 1,
-				InitialHolders: []*Holder{{
-					Amount:  123456789,
-					Address: addrStr,
-				}},
-			}, &reply))
-			require.Equal(changeAddrStr, reply.ChangeAddr)
+			},
+			kc,
+			key.Address(),
+		)
+		require.NoError(err)
+		issueAndAccept(require, env.vm, env.issuer, tx)
+
+		expectedAssets = append(expectedAssets, GetAssetDescriptionReply{
+			FormattedAssetID: FormattedAssetID{
+				AssetID: tx.ID(),
+			},
+			Name:         "Team Rocket " + symbol,
+			Symbol:       symbol,
+			Denomination: avajson.Uint8(i),
 		})
 	}
-}
 
-func TestCreateVariableCapAsset(t *testing.T) {
+	reply := GetAssetsReply{}
+	require.NoError(service.GetAssets(nil, &GetAssetsArgs{}, &reply))
+	require.Equal(expectedAssets, reply.Assets)
+	require.Equal(avajson.Uint64(len(expectedAssets)), reply.Cursor)
+
+	// Page through the assets two at a time
+	var (
+		pagedAssets []GetAssetDescriptionReply
+		cursor      avajson.Uint64
+	)
+	for {
+		reply := GetAssetsReply{}
+		require.NoError(service.GetAssets(nil, &GetAssetsArgs{
+			Cursor:   cursor,
+			PageSize: 2,
+		}, &reply))
+		if len(reply.Assets) == 0 {
+			break
+		}
+		require.LessOrEqual(len(reply.Assets), 2)
+		pagedAssets = append(pagedAssets, reply.Assets...)
+		cursor = reply.Cursor
+	}
+	require.Equal(expectedAssets, pagedAssets)
+
+	err := service.GetAssets(nil, &GetAssetsArgs{
+		PageSize: avajson.Uint64(maxPageSize + 1),
+	}, &reply)
+	require.ErrorContains(err, "pageSize > maximum allowed")
+}
+
+func TestGetAssetID(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	var (
+		key = keys[0]
+		kc  = secp256k1fx.NewKeychain(key)
+	)
+
+	var expectedAssets []GetAssetDescriptionReply
+	for _, name := range []string{"Team Rocket A", "Team Rocket B"} {
+		tx, err := env.txBuilder.CreateAssetTx(
+			name, // name
+			"TR", // symbol
+			0,    // denomination
+			map[uint32][]verify.State{
+				0: {
+					&secp256k1fx.TransferOutput{
+						Amt: 1,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{key.Address()},
+						},
+					},
+				},
+			},
+			kc,
+			key.Address(),
+		)
+		require.NoError(err)
+		issueAndAccept(require, env.vm, env.issuer, tx)
+
+		expectedAssets = append(expectedAssets, GetAssetDescriptionReply{
+			FormattedAssetID: FormattedAssetID{
+				AssetID: tx.ID(),
+			},
+			Name:   name,
+			Symbol: "TR",
+		})
+	}
+
+	// Both assets share the symbol
+	reply := GetAssetIDReply{}
+	require.NoError(service.GetAssetID(nil, &GetAssetIDArgs{
+		Query: "TR",
+	}, &reply))
+	require.Equal(expectedAssets, reply.Assets)
+
+	// Names are matched as well
+	reply = GetAssetIDReply{}
+	require.NoError(service.GetAssetID(nil, &GetAssetIDArgs{
+		Query: "Team Rocket B",
+	}, &reply))
+	require.Equal(expectedAssets[1:], reply.Assets)
+
+	reply = GetAssetIDReply{}
+	require.NoError(service.GetAssetID(nil, &GetAssetIDArgs{
+		Query: "tr",
+	}, &reply))
+	require.Empty(reply.Assets)
+}
+
+func TestGetBalance(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	avaxAssetID := env.genesisTx.ID()
+
+	reply := GetBalanceReply{}
+	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	require.NoError(service.GetBalance(nil, &GetBalanceArgs{
+		Address: addrStr,
+		AssetID: avaxAssetID.String(),
+	}, &reply))
+
+	require.Equal(startBalance, uint64(reply.Balance))
+}
+
+func TestCreateFixedCapAsset(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := setup(t, &envConfig{
+				isCustomFeeAsset: !tc.avaxAsset,
+				keystoreUsers: []*user{{
+					username:    username,
+					password:    password,
+					initialKeys: keys,
+				}},
+			})
+			service := &Service{vm: env.vm}
+			env.vm.ctx.Lock.Unlock()
+
+			reply := AssetIDChangeAddr{}
+			addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+			require.NoError(err)
+
+			changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+			require.NoError(err)
+			_, fromAddrsStr := sampleAddrs(t, env.vm.AddressManager, addrs)
+
+			require.NoError(service.CreateFixedCapAsset(nil, &CreateAssetArgs{
+				JSONSpendHeader: api.JSONSpendHeader{
+					UserPass: api.UserPass{
+						Username: username,
+						Password: password,
+					},
+					JSONFromAddrs:  api.JSONFromAddrs{From: fromAddrsStr},
+					JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+				},
+				Name:         "testAsset",
+				Symbol:       "TEST",
+				Denomination: 1,
+				InitialHolders: []*Holder{{
+					Amount:  123456789,
+					Address: addrStr,
+				}},
+			}, &reply))
+			require.Equal(changeAddrStr, reply.ChangeAddr)
+		})
+	}
+}
+
+func TestCreateVariableCapAsset(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			require := require.New(t)
@@ -2619,6 +4196,71 @@ func TestImportExportKey(t *testing.T) {
 	require.Equal(sk.Bytes(), exportReply.PrivateKey.Bytes())
 }
 
+func TestExportAllKeys(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexTransactions = true
+	vmDynamicConfig.ExportAllKeysEnabled = true
+	env := setup(t, &envConfig{
+		vmDynamicConfig: &vmDynamicConfig,
+		keystoreUsers: []*user{{
+			username: username,
+			password: password,
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	userPass := api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	expectedKeys := make(map[string][]byte)
+	for i := 0; i < 2; i++ {
+		sk, err := secp256k1.NewPrivateKey()
+		require.NoError(err)
+
+		importArgs := &ImportKeyArgs{
+			UserPass:   userPass,
+			PrivateKey: sk,
+		}
+		importReply := &api.JSONAddress{}
+		require.NoError(service.ImportKey(nil, importArgs, importReply))
+		expectedKeys[importReply.Address] = sk.Bytes()
+	}
+
+	reply := &ExportAllKeysReply{}
+	require.NoError(service.ExportAllKeys(nil, &userPass, reply))
+
+	keys := make(map[string][]byte)
+	for _, key := range reply.Keys {
+		keys[key.Address] = key.PrivateKey.Bytes()
+	}
+	require.Equal(expectedKeys, keys)
+}
+
+func TestExportAllKeysDisabled(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username: username,
+			password: password,
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	args := &api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	reply := &ExportAllKeysReply{}
+	err := service.ExportAllKeys(nil, args, reply)
+	require.ErrorIs(err, errBulkExportDisabled)
+}
+
 func TestImportAVMKeyNoDuplicates(t *testing.T) {
 	require := require.New(t)
 
@@ -2708,6 +4350,164 @@ func TestSend(t *testing.T) {
 	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
 }
 
+func TestServiceGetChangeAddress(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	fromAddrStr, err := env.vm.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+	changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+	require.NoError(err)
+
+	args := &GetChangeAddressArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+			JSONFromAddrs:  api.JSONFromAddrs{From: []string{fromAddrStr}},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+		},
+	}
+
+	// An explicit change address is echoed back.
+	reply := &api.JSONChangeAddr{}
+	require.NoError(service.GetChangeAddress(nil, args, reply))
+	require.Equal(changeAddrStr, reply.ChangeAddr)
+
+	// Without a change address, the change is sent to the from address.
+	args.ChangeAddr = ""
+	reply = &api.JSONChangeAddr{}
+	require.NoError(service.GetChangeAddress(nil, args, reply))
+	require.Equal(fromAddrStr, reply.ChangeAddr)
+
+	// With several from addresses, Send selects the same change address.
+	fromAddrStrs := make([]string, len(keys))
+	for i, key := range keys {
+		fromAddrStrs[i], err = env.vm.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+	}
+	args.From = fromAddrStrs
+	reply = &api.JSONChangeAddr{}
+	require.NoError(service.GetChangeAddress(nil, args, reply))
+	require.Contains(fromAddrStrs, reply.ChangeAddr)
+
+	toAddrStr, err := env.vm.FormatLocalAddress(ids.GenerateTestShortID())
+	require.NoError(err)
+	sendReply := &api.JSONTxIDChangeAddr{}
+	require.NoError(service.Send(nil, &SendArgs{
+		JSONSpendHeader: args.JSONSpendHeader,
+		SendOutput: SendOutput{
+			Amount:  500,
+			AssetID: env.genesisTx.ID().String(),
+			To:      toAddrStr,
+		},
+	}, sendReply))
+	require.Equal(reply.ChangeAddr, sendReply.ChangeAddr)
+}
+
+func TestServiceSimulateSend(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+
+	var (
+		from = ids.GenerateTestShortID()
+		to   = ids.GenerateTestShortID()
+		utxo = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: env.vm.feeAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 10 * testTxFee,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{from},
+				},
+			},
+		}
+	)
+	env.vm.state.AddUTXO(utxo)
+	require.NoError(env.vm.state.Commit())
+	env.vm.ctx.Lock.Unlock()
+
+	fromStr, err := env.vm.FormatLocalAddress(from)
+	require.NoError(err)
+	toStr, err := env.vm.FormatLocalAddress(to)
+	require.NoError(err)
+
+	reply := &SimulateSendReply{}
+	require.NoError(service.SimulateSend(nil, &SimulateSendArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fromStr}},
+		SendOutput: SendOutput{
+			Amount:  avajson.Uint64(3 * testTxFee),
+			AssetID: env.vm.feeAssetID.String(),
+			To:      toStr,
+		},
+	}, reply))
+	require.Equal(fromStr, reply.ChangeAddr)
+
+	type output struct {
+		AssetID ids.ID `json:"assetID"`
+		Output  struct {
+			Amount    uint64   `json:"amount"`
+			Addresses []string `json:"addresses"`
+		} `json:"output"`
+	}
+
+	require.Len(reply.Consumed, 1)
+	var consumed struct {
+		TxID        ids.ID `json:"txID"`
+		OutputIndex uint32 `json:"outputIndex"`
+		output
+	}
+	require.NoError(json.Unmarshal(reply.Consumed[0], &consumed))
+	require.Equal(utxo.TxID, consumed.TxID)
+	require.Equal(10*testTxFee, consumed.Output.Amount)
+
+	// The recipient receives the sent amount and the rest, less the fee, is
+	// returned as change.
+	amounts := make(map[string]uint64)
+	for _, createdBytes := range reply.Created {
+		var created output
+		require.NoError(json.Unmarshal(createdBytes, &created))
+		require.Equal(env.vm.feeAssetID, created.AssetID)
+		require.Len(created.Output.Addresses, 1)
+		amounts[created.Output.Addresses[0]] = created.Output.Amount
+	}
+	require.Equal(map[string]uint64{
+		toStr:   3 * testTxFee,
+		fromStr: 6 * testTxFee,
+	}, amounts)
+
+	// Nothing was issued.
+	require.Zero(env.vm.mempool.Len())
+
+	// A send that the addresses can't fund fails.
+	err = service.SimulateSend(nil, &SimulateSendArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fromStr}},
+		SendOutput: SendOutput{
+			Amount:  avajson.Uint64(10 * testTxFee),
+			AssetID: env.vm.feeAssetID.String(),
+			To:      toStr,
+		},
+	}, &SimulateSendReply{})
+	require.ErrorContains(err, "want to spend")
+}
+
 func TestSendMultiple(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2769,6 +4569,173 @@ func TestSendMultiple(t *testing.T) {
 	}
 }
 
+func TestSendMultipleAssets(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		isCustomFeeAsset: true,
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+		vmStaticConfig: &config.Config{
+			Upgrades: upgrade.Config{
+				EtnaTime: mockable.MaxTime,
+			},
+		},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	var (
+		feeAssetID   = env.genesisTx.ID()
+		otherAssetID = getCreateTxFromGenesisTest(t, env.genesisBytes, otherAssetName).ID()
+		feeAssetTo   = ids.GenerateTestShortID()
+		otherAssetTo = ids.GenerateTestShortID()
+	)
+
+	feeAssetToStr, err := env.vm.FormatLocalAddress(feeAssetTo)
+	require.NoError(err)
+	otherAssetToStr, err := env.vm.FormatLocalAddress(otherAssetTo)
+	require.NoError(err)
+	changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+	require.NoError(err)
+	_, fromAddrsStr := sampleAddrs(t, env.vm.AddressManager, addrs)
+
+	args := &SendMultipleArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+			JSONFromAddrs:  api.JSONFromAddrs{From: fromAddrsStr},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+		},
+		Outputs: []SendOutput{
+			{
+				Amount:  500,
+				AssetID: feeAssetID.String(),
+				To:      feeAssetToStr,
+			},
+			{
+				Amount:  1000,
+				AssetID: otherAssetID.String(),
+				To:      otherAssetToStr,
+			},
+		},
+	}
+	reply := &api.JSONTxIDChangeAddr{}
+	require.NoError(service.SendMultiple(nil, args, reply))
+	require.Equal(changeAddrStr, reply.ChangeAddr)
+
+	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
+
+	env.vm.ctx.Lock.Lock()
+	defer env.vm.ctx.Lock.Unlock()
+
+	tx, err := env.vm.state.GetTx(reply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.BaseTx{}, tx.Unsigned)
+	utx := tx.Unsigned.(*txs.BaseTx)
+
+	var (
+		consumed = make(map[ids.ID]uint64)
+		produced = make(map[ids.ID]uint64)
+		// recipient --> asset --> amount
+		received = make(map[ids.ShortID]map[ids.ID]uint64)
+	)
+	for _, in := range utx.Ins {
+		consumed[in.AssetID()] += in.Input().Amount()
+	}
+	for _, out := range utx.Outs {
+		assetID := out.AssetID()
+		produced[assetID] += out.Output().Amount()
+
+		require.IsType(&secp256k1fx.TransferOutput{}, out.Out)
+		transferOut := out.Out.(*secp256k1fx.TransferOutput)
+		require.Len(transferOut.Addrs, 1)
+		to := transferOut.Addrs[0]
+		if received[to] == nil {
+			received[to] = make(map[ids.ID]uint64)
+		}
+		received[to][assetID] += transferOut.Amt
+	}
+
+	require.Equal(map[ids.ID]uint64{feeAssetID: 500}, received[feeAssetTo])
+	require.Equal(map[ids.ID]uint64{otherAssetID: 1000}, received[otherAssetTo])
+
+	// The fee is only burned in the fee asset.
+	require.Equal(env.vm.TxFee, consumed[feeAssetID]-produced[feeAssetID])
+	require.Equal(consumed[otherAssetID], produced[otherAssetID])
+}
+
+func TestEstimateTxSize(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+		vmStaticConfig: &config.Config{
+			Upgrades: upgrade.Config{
+				EtnaTime: mockable.MaxTime,
+			},
+		},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	assetID := env.genesisTx.ID()
+	addr := keys[0].PublicKey().Address()
+
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+	changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+	require.NoError(err)
+	_, fromAddrsStr := sampleAddrs(t, env.vm.AddressManager, addrs)
+
+	args := SendMultipleArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+			JSONFromAddrs:  api.JSONFromAddrs{From: fromAddrsStr},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+		},
+		Outputs: []SendOutput{
+			{
+				Amount:  500,
+				AssetID: assetID.String(),
+				To:      addrStr,
+			},
+		},
+		Memo: "estimate me",
+	}
+
+	estimateReply := &EstimateTxSizeReply{}
+	require.NoError(service.EstimateTxSize(nil, &EstimateTxSizeArgs{
+		SendMultipleArgs: args,
+	}, estimateReply))
+
+	sendReply := &api.JSONTxIDChangeAddr{}
+	require.NoError(service.SendMultiple(nil, &args, sendReply))
+	buildAndAccept(require, env.vm, env.issuer, sendReply.TxID)
+
+	env.vm.ctx.Lock.Lock()
+	defer env.vm.ctx.Lock.Unlock()
+
+	tx, err := env.vm.state.GetTx(sendReply.TxID)
+	require.NoError(err)
+
+	// Placeholder signatures have the same length as real signatures, so the
+	// estimate is exact.
+	require.Equal(uint64(len(tx.Bytes())), uint64(estimateReply.Size))
+}
+
 func TestCreateAndListAddresses(t *testing.T) {
 	require := require.New(t)
 
@@ -2791,14 +4758,150 @@ func TestCreateAndListAddresses(t *testing.T) {
 
 	newAddr := createReply.Address
 
-	listArgs := &api.UserPass{
+	listArgs := &api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	listReply := &api.JSONAddresses{}
+
+	require.NoError(service.ListAddresses(nil, listArgs, listReply))
+	require.Contains(listReply.Addresses, newAddr)
+}
+
+func TestListAddressesWithBalances(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username: username,
+			password: password,
+		}},
+	})
+	service := &Service{vm: env.vm}
+
+	fundedKey, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	unfundedKey, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	fundedAddr := fundedKey.Address()
+	env.vm.state.AddUTXO(&avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: avax.Asset{ID: env.vm.feeAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1337,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{fundedAddr},
+			},
+		},
+	})
+	require.NoError(env.vm.state.Commit())
+
+	env.vm.ctx.Lock.Unlock()
+
+	userPass := api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	for _, key := range []*secp256k1.PrivateKey{fundedKey, unfundedKey} {
+		require.NoError(service.ImportKey(nil, &ImportKeyArgs{
+			UserPass:   userPass,
+			PrivateKey: key,
+		}, &api.JSONAddress{}))
+	}
+
+	fundedAddrStr, err := env.vm.FormatLocalAddress(fundedAddr)
+	require.NoError(err)
+	unfundedAddrStr, err := env.vm.FormatLocalAddress(unfundedKey.Address())
+	require.NoError(err)
+	avaxAlias := env.vm.PrimaryAliasOrDefault(env.vm.feeAssetID)
+
+	// Only the AVAX balance is reported, even if it is zero.
+	reply := &ListAddressesWithBalancesReply{}
+	require.NoError(service.ListAddressesWithBalances(nil, &ListAddressesWithBalancesArgs{
+		UserPass: userPass,
+	}, reply))
+	require.ElementsMatch([]AddressBalances{
+		{
+			Address: fundedAddrStr,
+			Balances: []Balance{{
+				AssetID: avaxAlias,
+				Balance: 1337,
+			}},
+		},
+		{
+			Address: unfundedAddrStr,
+			Balances: []Balance{{
+				AssetID: avaxAlias,
+				Balance: 0,
+			}},
+		},
+	}, reply.Addresses)
+
+	// Only non-zero balances are reported for all assets.
+	reply = &ListAddressesWithBalancesReply{}
+	require.NoError(service.ListAddressesWithBalances(nil, &ListAddressesWithBalancesArgs{
+		UserPass:  userPass,
+		AllAssets: true,
+	}, reply))
+	require.ElementsMatch([]AddressBalances{
+		{
+			Address: fundedAddrStr,
+			Balances: []Balance{{
+				AssetID: avaxAlias,
+				Balance: 1337,
+			}},
+		},
+		{
+			Address:  unfundedAddrStr,
+			Balances: []Balance{},
+		},
+	}, reply.Addresses)
+}
+
+func TestListAddressesWithBalancesHRP(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys[:1],
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	userPass := api.UserPass{
 		Username: username,
 		Password: password,
 	}
-	listReply := &api.JSONAddresses{}
 
-	require.NoError(service.ListAddresses(nil, listArgs, listReply))
-	require.Contains(listReply.Addresses, newAddr)
+	chainIDAlias, err := env.vm.ctx.BCLookup.PrimaryAlias(env.vm.ctx.ChainID)
+	require.NoError(err)
+	expectedAddrStr, err := address.Format(chainIDAlias, "custom", keys[0].Address().Bytes())
+	require.NoError(err)
+
+	reply := &ListAddressesWithBalancesReply{}
+	require.NoError(service.ListAddressesWithBalances(nil, &ListAddressesWithBalancesArgs{
+		UserPass: userPass,
+		HRP:      "custom",
+	}, reply))
+	require.Len(reply.Addresses, 1)
+	require.Equal(expectedAddrStr, reply.Addresses[0].Address)
+	require.True(strings.HasPrefix(reply.Addresses[0].Address, chainIDAlias+"-custom1"))
+
+	for _, hrp := range []string{"Custom", "cust om"} {
+		err := service.ListAddressesWithBalances(nil, &ListAddressesWithBalancesArgs{
+			UserPass: userPass,
+			HRP:      hrp,
+		}, &ListAddressesWithBalancesReply{})
+		require.ErrorIs(err, errInvalidHRP)
+	}
 }
 
 func TestImport(t *testing.T) {
@@ -2864,6 +4967,98 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportUTXOIDs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	assetID := env.genesisTx.ID()
+	addr0 := keys[0].PublicKey().Address()
+
+	// The last UTXO is owned by an address that the user doesn't control.
+	owners := []ids.ShortID{addr0, addr0, ids.GenerateTestShortID()}
+	utxos := make([]*avax.UTXO, len(owners))
+	elems := make([]*atomic.Element, len(utxos))
+	for i, owner := range owners {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.Empty,
+				OutputIndex: uint32(i),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 7,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{owner},
+				},
+			},
+		}
+		utxoBytes, err := env.vm.parser.Codec().Marshal(txs.CodecVersion, utxos[i])
+		require.NoError(err)
+
+		utxoID := utxos[i].InputID()
+		elems[i] = &atomic.Element{
+			Key:   utxoID[:],
+			Value: utxoBytes,
+			Traits: [][]byte{
+				owner.Bytes(),
+			},
+		}
+	}
+
+	peerSharedMemory := env.sharedMemory.NewSharedMemory(constants.PlatformChainID)
+	require.NoError(peerSharedMemory.Apply(map[ids.ID]*atomic.Requests{
+		env.vm.ctx.ChainID: {
+			PutRequests: elems,
+		},
+	}))
+
+	addrStr, err := env.vm.FormatLocalAddress(addr0)
+	require.NoError(err)
+	args := &ImportArgs{
+		UserPass: api.UserPass{
+			Username: username,
+			Password: password,
+		},
+		SourceChain: "P",
+		To:          addrStr,
+		UTXOIDs:     []avax.UTXOID{utxos[1].UTXOID},
+	}
+	tx, err := service.buildImport(args)
+	require.NoError(err)
+
+	importTx := tx.Unsigned.(*txs.ImportTx)
+	require.Len(importTx.ImportedIns, 1)
+	require.Equal(utxos[1].InputID(), importTx.ImportedIns[0].InputID())
+
+	// Importing a UTXO that isn't in shared memory should fail.
+	args.UTXOIDs = []avax.UTXOID{{
+		TxID:        ids.Empty,
+		OutputIndex: uint32(len(utxos)),
+	}}
+	_, err = service.buildImport(args)
+	require.ErrorIs(err, errMissingAtomicUTXO)
+
+	// Listing the same UTXO twice should fail.
+	args.UTXOIDs = []avax.UTXOID{utxos[0].UTXOID, utxos[0].UTXOID}
+	_, err = service.buildImport(args)
+	require.ErrorIs(err, errDuplicateUTXO)
+
+	// Importing a UTXO that the user can't spend should fail.
+	args.UTXOIDs = []avax.UTXOID{utxos[0].UTXOID, utxos[2].UTXOID}
+	_, err = service.buildImport(args)
+	require.ErrorIs(err, errUnspendableUTXO)
+}
+
 func TestServiceGetBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -3029,6 +5224,141 @@ func TestServiceGetBlock(t *testing.T) {
 	}
 }
 
+func TestServiceGetBlockIncludeTxStatuses(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	env.vm.ctx.Lock.Lock()
+	blkID, err := env.vm.state.GetTxBlockID(newTx.ID())
+	env.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	reply := &api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &api.GetBlockArgs{
+		BlockID:  blkID,
+		Encoding: formatting.JSON,
+	}, reply))
+	require.Nil(reply.TxStatuses)
+
+	reply = &api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &api.GetBlockArgs{
+		BlockID:           blkID,
+		Encoding:          formatting.JSON,
+		IncludeTxStatuses: true,
+	}, reply))
+	require.Equal(
+		map[ids.ID]choices.Status{
+			newTx.ID(): choices.Accepted,
+		},
+		reply.TxStatuses,
+	)
+}
+
+func TestServiceGetBlockIncludeTxStatusesProcessing(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	txID, err := env.vm.issueTxFromRPC(newTx)
+	require.NoError(err)
+	require.Equal(common.PendingTxs, <-env.issuer)
+
+	// Verify, but don't accept, a block containing [newTx].
+	env.vm.ctx.Lock.Lock()
+	blk, err := env.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	env.vm.ctx.Lock.Unlock()
+
+	reply := &api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &api.GetBlockArgs{
+		BlockID:           blk.ID(),
+		Encoding:          formatting.JSON,
+		IncludeTxStatuses: true,
+	}, reply))
+	require.Equal(
+		map[ids.ID]choices.Status{
+			txID: choices.Processing,
+		},
+		reply.TxStatuses,
+	)
+}
+
+func TestServiceGetBlocksByID(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	genesisBlkID := env.vm.state.GetLastAccepted()
+	env.vm.ctx.Lock.Unlock()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	env.vm.ctx.Lock.Lock()
+	blkID, err := env.vm.state.GetTxBlockID(newTx.ID())
+	require.NoError(err)
+	genesisBlk, err := env.vm.state.GetBlock(genesisBlkID)
+	require.NoError(err)
+	blk, err := env.vm.state.GetBlock(blkID)
+	require.NoError(err)
+	env.vm.ctx.Lock.Unlock()
+
+	expectedBlock := func(blk block.Block) json.RawMessage {
+		blkStr, err := formatting.Encode(formatting.Hex, blk.Bytes())
+		require.NoError(err)
+		blkJSON, err := json.Marshal(blkStr)
+		require.NoError(err)
+		return blkJSON
+	}
+
+	missingBlkID := ids.GenerateTestID()
+	reply := &GetBlocksByIDReply{}
+	require.NoError(service.GetBlocksByID(nil, &GetBlocksByIDArgs{
+		BlockIDs: []ids.ID{blkID, missingBlkID, genesisBlkID},
+		Encoding: formatting.Hex,
+	}, reply))
+	require.Equal(
+		&GetBlocksByIDReply{
+			Blocks: []BlockByID{
+				{
+					BlockID: blkID,
+					Block:   expectedBlock(blk),
+				},
+				{
+					BlockID: genesisBlkID,
+					Block:   expectedBlock(genesisBlk),
+				},
+			},
+			Missing:  []ids.ID{missingBlkID},
+			Encoding: formatting.Hex,
+		},
+		reply,
+	)
+
+	// Requesting too many blocks fails the whole call.
+	err = service.GetBlocksByID(nil, &GetBlocksByIDArgs{
+		BlockIDs: make([]ids.ID, maxGetBlocksByIDBlocks+1),
+		Encoding: formatting.Hex,
+	}, &GetBlocksByIDReply{})
+	require.ErrorIs(err, errTooManyBlockIDs)
+}
+
 func TestServiceGetBlockByHeight(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -3235,6 +5565,354 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 	}
 }
 
+func TestServiceGetArgSchemas(t *testing.T) {
+	require := require.New(t)
+
+	service := &Service{
+		vm: &VM{
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	reply := ArgSchemasReply{}
+	require.NoError(service.GetArgSchemas(nil, nil, &reply))
+	for _, name := range []string{"CreateAssetArgs", "MintArgs", "SendArgs", "GetUTXOsArgs"} {
+		require.Contains(reply.Schemas, name)
+	}
+
+	sendArgsSchema := reply.Schemas["SendArgs"]
+	require.Equal("object", sendArgsSchema.Type)
+	for _, name := range []string{"amount", "assetID", "to", "memo", "username", "from", "changeAddr"} {
+		require.Contains(sendArgsSchema.Properties, name)
+	}
+	require.Equal(&JSONSchema{Type: "string"}, sendArgsSchema.Properties["amount"])
+	require.Equal(&JSONSchema{Type: "string"}, sendArgsSchema.Properties["assetID"])
+	require.Equal(&JSONSchema{Type: "string"}, sendArgsSchema.Properties["to"])
+	require.Equal(
+		&JSONSchema{
+			Type:  "array",
+			Items: &JSONSchema{Type: "string"},
+		},
+		sendArgsSchema.Properties["from"],
+	)
+}
+
+func TestServiceGetFeeConfig(t *testing.T) {
+	require := require.New(t)
+
+	service := &Service{
+		vm: &VM{
+			Config: config.Config{
+				TxFee:            1,
+				CreateAssetTxFee: 2,
+			},
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	reply := GetFeeConfigReply{}
+	require.NoError(service.GetFeeConfig(nil, nil, &reply))
+	require.Equal(GetFeeConfigReply{
+		TxFee:            1,
+		CreateAssetTxFee: 2,
+	}, reply)
+}
+
+func TestServiceGetFeeAsset(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := setup(t, &envConfig{
+				isCustomFeeAsset: !tc.avaxAsset,
+			})
+			service := &Service{vm: env.vm}
+			env.vm.ctx.Lock.Unlock()
+
+			expectedName := "AVAX"
+			if !tc.avaxAsset {
+				expectedName = feeAssetName
+			}
+
+			reply := GetAssetDescriptionReply{}
+			require.NoError(service.GetFeeAsset(nil, nil, &reply))
+			require.Equal(env.genesisTx.ID(), reply.AssetID)
+			require.Equal(env.vm.feeAssetID, reply.AssetID)
+			require.Equal(expectedName, reply.Name)
+		})
+	}
+}
+
+func TestServiceEstimateConfirmationTime(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.OptimalProcessing = 2
+
+	// With an empty mempool, a tx is expected to be included in the next
+	// block.
+	env.vm.ctx.Lock.Unlock()
+	reply := EstimateConfirmationTimeReply{}
+	require.NoError(service.EstimateConfirmationTime(nil, nil, &reply))
+	require.Equal(EstimateConfirmationTimeReply{
+		Blocks: 1,
+		Speed:  ConfirmationFast,
+	}, reply)
+	env.vm.ctx.Lock.Lock()
+
+	var pendingTxs []*txs.Tx
+	mempool := xmempool.NewMockMempool(gomock.NewController(t))
+	mempool.EXPECT().Iterate(gomock.Any()).DoAndReturn(func(f func(*txs.Tx) bool) {
+		for _, tx := range pendingTxs {
+			if !f(tx) {
+				return
+			}
+		}
+	}).AnyTimes()
+	env.vm.mempool = mempool
+	env.vm.ctx.Lock.Unlock()
+
+	tests := []struct {
+		pendingBlocks int
+		expectedSpeed string
+	}{
+		{
+			// The blocks ahead of the tx's block can be processed alongside
+			// it.
+			pendingBlocks: 1,
+			expectedSpeed: ConfirmationMedium,
+		},
+		{
+			// The tx's block must wait for a block ahead of it to be accepted.
+			pendingBlocks: 2,
+			expectedSpeed: ConfirmationSlow,
+		},
+	}
+	for _, test := range tests {
+		pendingTxs = make([]*txs.Tx, test.pendingBlocks)
+		for i := range pendingTxs {
+			tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
+			tx.SetBytes(nil, make([]byte, blockbuilder.TargetBlockSize))
+			pendingTxs[i] = tx
+		}
+
+		reply := EstimateConfirmationTimeReply{}
+		require.NoError(service.EstimateConfirmationTime(nil, nil, &reply))
+		require.Equal(avajson.Uint64(test.pendingBlocks+1), reply.Blocks)
+		require.Equal(test.expectedSpeed, reply.Speed)
+	}
+}
+
+func TestServiceGetBlockRangeByTime(t *testing.T) {
+	// Accepted blocks at heights 0 through 4 with timestamps 10, 20, 30, 30
+	// and 50.
+	timestamps := []int64{10, 20, 30, 30, 50}
+
+	tests := []struct {
+		name            string
+		startTime       uint64
+		endTime         uint64
+		cursor          uint64
+		expectedHeights []uint64
+		expectedErr     error
+	}{
+		{
+			name:            "full chain",
+			startTime:       0,
+			endTime:         100,
+			expectedHeights: []uint64{0, 1, 2, 3, 4},
+		},
+		{
+			name:            "cursor within window",
+			startTime:       20,
+			endTime:         30,
+			cursor:          3,
+			expectedHeights: []uint64{3},
+		},
+		{
+			name:            "cursor before window",
+			startTime:       20,
+			endTime:         30,
+			cursor:          1,
+			expectedHeights: []uint64{1, 2, 3},
+		},
+		{
+			name:            "cursor after window",
+			startTime:       20,
+			endTime:         30,
+			cursor:          5,
+			expectedHeights: []uint64{},
+		},
+		{
+			name:            "inclusive bounds",
+			startTime:       20,
+			endTime:         30,
+			expectedHeights: []uint64{1, 2, 3},
+		},
+		{
+			name:            "single timestamp",
+			startTime:       30,
+			endTime:         30,
+			expectedHeights: []uint64{2, 3},
+		},
+		{
+			name:            "empty range between blocks",
+			startTime:       31,
+			endTime:         49,
+			expectedHeights: []uint64{},
+		},
+		{
+			name:            "before genesis",
+			startTime:       0,
+			endTime:         9,
+			expectedHeights: []uint64{},
+		},
+		{
+			name:            "after last accepted",
+			startTime:       45,
+			endTime:         1000,
+			expectedHeights: []uint64{4},
+		},
+		{
+			name:            "beyond last accepted",
+			startTime:       51,
+			endTime:         1000,
+			expectedHeights: []uint64{},
+		},
+		{
+			name:        "end before start",
+			startTime:   30,
+			endTime:     20,
+			expectedErr: errInvalidTimeRange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := state.NewMockState(ctrl)
+			manager := executor.NewMockManager(ctrl)
+			blockIDs := make([]ids.ID, len(timestamps))
+			for height, timestamp := range timestamps {
+				blockID := ids.GenerateTestID()
+				blockIDs[height] = blockID
+
+				block := block.NewMockBlock(ctrl)
+				block.EXPECT().ID().Return(blockID).AnyTimes()
+				block.EXPECT().Height().Return(uint64(height)).AnyTimes()
+				block.EXPECT().Timestamp().Return(time.Unix(timestamp, 0)).AnyTimes()
+
+				state.EXPECT().GetBlockIDAtHeight(uint64(height)).Return(blockID, nil).AnyTimes()
+				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil).AnyTimes()
+			}
+			state.EXPECT().GetLastAccepted().Return(blockIDs[len(blockIDs)-1]).AnyTimes()
+
+			service := &Service{
+				vm: &VM{
+					state:        state,
+					chainManager: manager,
+					ctx: &snow.Context{
+						Log: logging.NoLog{},
+					},
+				},
+			}
+
+			reply := &GetBlockRangeByTimeReply{}
+			err := service.GetBlockRangeByTime(nil, &GetBlockRangeByTimeArgs{
+				StartTime: avajson.Uint64(test.startTime),
+				EndTime:   avajson.Uint64(test.endTime),
+				Cursor:    avajson.Uint64(test.cursor),
+			}, reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.False(reply.Truncated)
+			require.Len(reply.Blocks, len(test.expectedHeights))
+			for i, height := range test.expectedHeights {
+				require.Equal(blockIDs[height], reply.Blocks[i].BlockID)
+				require.Equal(avajson.Uint64(height), reply.Blocks[i].Height)
+				require.Equal(avajson.Uint64(timestamps[height]), reply.Blocks[i].Timestamp)
+			}
+		})
+	}
+}
+
+func TestServiceGetBlockRangeByTimeTruncated(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// More blocks than fit in a page share the same timestamp.
+	const numBlocks = maxPageSize + 1
+	timestamp := time.Unix(10, 0)
+
+	state := state.NewMockState(ctrl)
+	manager := executor.NewMockManager(ctrl)
+	blockIDs := make([]ids.ID, numBlocks)
+	for height := range blockIDs {
+		blockID := ids.GenerateTestID()
+		blockIDs[height] = blockID
+
+		block := block.NewMockBlock(ctrl)
+		block.EXPECT().ID().Return(blockID).AnyTimes()
+		block.EXPECT().Height().Return(uint64(height)).AnyTimes()
+		block.EXPECT().Timestamp().Return(timestamp).AnyTimes()
+
+		state.EXPECT().GetBlockIDAtHeight(uint64(height)).Return(blockID, nil).AnyTimes()
+		manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil).AnyTimes()
+	}
+	state.EXPECT().GetLastAccepted().Return(blockIDs[numBlocks-1]).AnyTimes()
+
+	service := &Service{
+		vm: &VM{
+			state:        state,
+			chainManager: manager,
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	args := &GetBlockRangeByTimeArgs{
+		StartTime: avajson.Uint64(timestamp.Unix()),
+		EndTime:   avajson.Uint64(timestamp.Unix()),
+	}
+	reply := &GetBlockRangeByTimeReply{}
+	require.NoError(service.GetBlockRangeByTime(nil, args, reply))
+	require.Len(reply.Blocks, int(maxPageSize))
+	require.True(reply.Truncated)
+	require.Equal(avajson.Uint64(maxPageSize), reply.Cursor)
+
+	// Resuming from the cursor should return the rest of the window.
+	args.Cursor = reply.Cursor
+	reply = &GetBlockRangeByTimeReply{}
+	require.NoError(service.GetBlockRangeByTime(nil, args, reply))
+	require.Len(reply.Blocks, 1)
+	require.Equal(blockIDs[numBlocks-1], reply.Blocks[0].BlockID)
+	require.False(reply.Truncated)
+}
+
+func TestServiceGetBlockRangeByTimeNotLinearized(t *testing.T) {
+	service := &Service{
+		vm: &VM{
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+	err := service.GetBlockRangeByTime(nil, &GetBlockRangeByTimeArgs{}, &GetBlockRangeByTimeReply{})
+	require.ErrorIs(t, err, errNotLinearized)
+}
+
 func TestServiceGetHeight(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -275,6 +275,36 @@ func (mr *MockStateMockRecorder) AddUTXO(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUTXO", reflect.TypeOf((*MockState)(nil).AddUTXO), arg0)
 }
 
+// AssetIDs mocks base method.
+func (m *MockState) AssetIDs(arg0 uint64, arg1 int) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssetIDs", arg0, arg1)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssetIDs indicates an expected call of AssetIDs.
+func (mr *MockStateMockRecorder) AssetIDs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetIDs", reflect.TypeOf((*MockState)(nil).AssetIDs), arg0, arg1)
+}
+
+// AssetIDsByName mocks base method.
+func (m *MockState) AssetIDsByName(arg0 string) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssetIDsByName", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssetIDsByName indicates an expected call of AssetIDsByName.
+func (mr *MockStateMockRecorder) AssetIDsByName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetIDsByName", reflect.TypeOf((*MockState)(nil).AssetIDsByName), arg0)
+}
+
 // Checksums mocks base method.
 func (m *MockState) Checksums() (ids.ID, ids.ID) {
 	m.ctrl.T.Helper()
@@ -418,6 +448,21 @@ func (mr *MockStateMockRecorder) GetTx(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockState)(nil).GetTx), arg0)
 }
 
+// GetTxBlockID mocks base method.
+func (m *MockState) GetTxBlockID(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxBlockID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxBlockID indicates an expected call of GetTxBlockID.
+func (mr *MockStateMockRecorder) GetTxBlockID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxBlockID", reflect.TypeOf((*MockState)(nil).GetTxBlockID), arg0)
+}
+
 // GetUTXO mocks base method.
 func (m *MockState) GetUTXO(arg0 ids.ID) (*avax.UTXO, error) {
 	m.ctrl.T.Helper()
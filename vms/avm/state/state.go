@@ -4,8 +4,10 @@
 package state
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,6 +18,9 @@ import (
 	"github.com/CaiJiJi/avalanchego/database/prefixdb"
 	"github.com/CaiJiJi/avalanchego/database/versiondb"
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/utils"
+	"github.com/CaiJiJi/avalanchego/utils/hashing"
+	"github.com/CaiJiJi/avalanchego/utils/set"
 	"github.com/CaiJiJi/avalanchego/vms/avm/block"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
@@ -25,6 +30,10 @@ const (
 	txCacheSize      = 8192
 	blockIDCacheSize = 8192
 	blockCacheSize   = 2048
+
+	// txBlocksIndexCommitInterval is the number of blocks indexed between
+	// commits while populating the tx block index.
+	txBlocksIndexCommitInterval = 1024
 )
 
 var (
@@ -32,13 +41,22 @@ var (
 	txPrefix        = []byte("tx")
 	blockIDPrefix   = []byte("blockID")
 	blockPrefix     = []byte("block")
+	assetPrefix     = []byte("asset")
+	assetNamePrefix = []byte("assetName")
+	txBlockPrefix   = []byte("txBlock")
 	singletonPrefix = []byte("singleton")
 
-	isInitializedKey = []byte{0x00}
-	timestampKey     = []byte{0x01}
-	lastAcceptedKey  = []byte{0x02}
+	isInitializedKey     = []byte{0x00}
+	timestampKey         = []byte{0x01}
+	lastAcceptedKey      = []byte{0x02}
+	numAssetsKey         = []byte{0x03}
+	assetsIndexedKey     = []byte{0x04}
+	txBlocksIndexedKey   = []byte{0x05}
+	assetNamesIndexedKey = []byte{0x06}
 
 	_ State = (*state)(nil)
+
+	errTxNotCreateAsset = errors.New("tx is not a CreateAssetTx")
 )
 
 type ReadOnlyChain interface {
@@ -71,6 +89,23 @@ type State interface {
 	IsInitialized() (bool, error)
 	SetInitialized() error
 
+	// AssetIDs returns the IDs of the assets created on this chain, in the
+	// order they were accepted, starting at index [start]. At most [limit]
+	// IDs are returned.
+	//
+	// Assets that were accepted before the chain was linearized have no
+	// acceptance order. They are returned first, ordered by ID.
+	AssetIDs(start uint64, limit int) ([]ids.ID, error)
+
+	// AssetIDsByName returns the IDs of the assets created on this chain whose
+	// symbol or name is exactly [name], in the same order as [AssetIDs].
+	AssetIDsByName(name string) ([]ids.ID, error)
+
+	// GetTxBlockID returns the ID of the accepted block that included
+	// [txID]. If [txID] wasn't accepted in a block, such as txs accepted
+	// before the chain was linearized, database.ErrNotFound is returned.
+	GetTxBlockID(txID ids.ID) (ids.ID, error)
+
 	// InitializeChainState is called after the VM has been linearized. Calling
 	// [GetLastAccepted] or [GetTimestamp] before calling this function will
 	// return uninitialized data.
@@ -105,10 +140,20 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. assets
+ * | '-- index -> assetID
+ * |-. assetNames
+ * | '-- hash(symbol or name) + assetID -> index
+ * |-. txBlock
+ * | '-- txID -> blockID
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   |-- numAssetsKey -> numAssets
+ *   |-- assetsIndexedKey -> nil
+ *   |-- txBlocksIndexedKey -> nil
+ *   '-- assetNamesIndexedKey -> nil
  */
 type state struct {
 	parser block.Parser
@@ -130,6 +175,14 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, block.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	addedAssetIDs []ids.ID // assetIDs created since the last commit, in order
+	numAssets     uint64   // number of assetIDs written to [assetDB]
+	assetDB       database.Database
+	assetNameDB   database.Database
+
+	addedTxBlockIDs map[ids.ID]ids.ID // map of txID -> blockID
+	txBlockDB       database.Database
+
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
@@ -149,6 +202,9 @@ func New(
 	txDB := prefixdb.New(txPrefix, db)
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
+	assetDB := prefixdb.New(assetPrefix, db)
+	assetNameDB := prefixdb.New(assetNamePrefix, db)
+	txBlockDB := prefixdb.New(txBlockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
 
 	txCache, err := metercacher.New[ids.ID, *txs.Tx](
@@ -183,6 +239,11 @@ func New(
 		return nil, err
 	}
 
+	numAssets, err := database.GetUInt64(singletonDB, numAssetsKey)
+	if err != nil && err != database.ErrNotFound {
+		return nil, err
+	}
+
 	s := &state{
 		parser: parser,
 		db:     db,
@@ -203,10 +264,28 @@ func New(
 		blockCache:  blockCache,
 		blockDB:     blockDB,
 
+		numAssets:   numAssets,
+		assetDB:     assetDB,
+		assetNameDB: assetNameDB,
+
+		addedTxBlockIDs: make(map[ids.ID]ids.ID),
+		txBlockDB:       txBlockDB,
+
 		singletonDB: singletonDB,
 
 		trackChecksum: trackChecksums,
 	}
+	// The asset index is ordered using the tx block index, so the tx block
+	// index must be populated first.
+	if err := s.indexTxBlocks(); err != nil {
+		return nil, fmt.Errorf("failed to index tx blocks: %w", err)
+	}
+	if err := s.indexAssets(); err != nil {
+		return nil, fmt.Errorf("failed to index assets: %w", err)
+	}
+	if err := s.indexAssetNames(); err != nil {
+		return nil, fmt.Errorf("failed to index asset names: %w", err)
+	}
 	return s, s.initTxChecksum()
 }
 
@@ -266,6 +345,9 @@ func (s *state) AddTx(tx *txs.Tx) {
 	txID := tx.ID()
 	s.updateTxChecksum(txID)
 	s.addedTxs[txID] = tx
+	if _, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+		s.addedAssetIDs = append(s.addedAssetIDs, txID)
+	}
 }
 
 func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
@@ -329,6 +411,96 @@ func (s *state) AddBlock(block block.Block) {
 	blkID := block.ID()
 	s.addedBlockIDs[block.Height()] = blkID
 	s.addedBlocks[blkID] = block
+	for _, tx := range block.Txs() {
+		s.addedTxBlockIDs[tx.ID()] = blkID
+	}
+}
+
+func (s *state) GetTxBlockID(txID ids.ID) (ids.ID, error) {
+	if blkID, exists := s.addedTxBlockIDs[txID]; exists {
+		return blkID, nil
+	}
+	return database.GetID(s.txBlockDB, txID[:])
+}
+
+func (s *state) AssetIDs(start uint64, limit int) ([]ids.ID, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	assetIDs := make([]ids.ID, 0, limit)
+	if start < s.numAssets {
+		it := s.assetDB.NewIteratorWithStart(database.PackUInt64(start))
+		defer it.Release()
+
+		for len(assetIDs) < limit && it.Next() {
+			assetID, err := ids.ToID(it.Value())
+			if err != nil {
+				return nil, err
+			}
+			assetIDs = append(assetIDs, assetID)
+		}
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+		start = s.numAssets
+	}
+
+	// Include the assets that have been added but not yet committed.
+	pendingStart := start - s.numAssets
+	for i := pendingStart; len(assetIDs) < limit && i < uint64(len(s.addedAssetIDs)); i++ {
+		assetIDs = append(assetIDs, s.addedAssetIDs[i])
+	}
+	return assetIDs, nil
+}
+
+func (s *state) AssetIDsByName(name string) ([]ids.ID, error) {
+	type indexedAsset struct {
+		index   uint64
+		assetID ids.ID
+	}
+	var assets []indexedAsset
+
+	it := s.assetNameDB.NewIteratorWithPrefix(hashing.ComputeHash256([]byte(name)))
+	defer it.Release()
+
+	for it.Next() {
+		assetID, err := ids.ToID(it.Key()[hashing.HashLen:])
+		if err != nil {
+			return nil, err
+		}
+		index, err := database.ParseUInt64(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, indexedAsset{
+			index:   index,
+			assetID: assetID,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(assets, func(a, b indexedAsset) int {
+		return cmp.Compare(a.index, b.index)
+	})
+
+	assetIDs := make([]ids.ID, len(assets), len(assets)+len(s.addedAssetIDs))
+	for i, asset := range assets {
+		assetIDs[i] = asset.assetID
+	}
+
+	// Include the assets that have been added but not yet committed.
+	for _, assetID := range s.addedAssetIDs {
+		symbol, assetName, err := s.getAssetNames(assetID)
+		if err != nil {
+			return nil, err
+		}
+		if symbol == name || assetName == name {
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+	return assetIDs, nil
 }
 
 func (s *state) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
@@ -413,6 +585,9 @@ func (s *state) Close() error {
 		s.txDB.Close(),
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
+		s.assetDB.Close(),
+		s.assetNameDB.Close(),
+		s.txBlockDB.Close(),
 		s.singletonDB.Close(),
 		s.db.Close(),
 	)
@@ -424,6 +599,8 @@ func (s *state) write() error {
 		s.writeTxs(),
 		s.writeBlockIDs(),
 		s.writeBlocks(),
+		s.writeAssets(),
+		s.writeTxBlockIDs(),
 		s.writeMetadata(),
 	)
 }
@@ -486,6 +663,41 @@ func (s *state) writeBlocks() error {
 	return nil
 }
 
+func (s *state) writeAssets() error {
+	if len(s.addedAssetIDs) == 0 {
+		return nil
+	}
+
+	for _, assetID := range s.addedAssetIDs {
+		indexKey := database.PackUInt64(s.numAssets)
+		if err := database.PutID(s.assetDB, indexKey, assetID); err != nil {
+			return fmt.Errorf("failed to add assetID: %w", err)
+		}
+		if err := s.putAssetNames(assetID, s.numAssets); err != nil {
+			return err
+		}
+		s.numAssets++
+	}
+	s.addedAssetIDs = nil
+
+	if err := database.PutUInt64(s.singletonDB, numAssetsKey, s.numAssets); err != nil {
+		return fmt.Errorf("failed to write number of assets: %w", err)
+	}
+	return nil
+}
+
+func (s *state) writeTxBlockIDs() error {
+	for txID, blkID := range s.addedTxBlockIDs {
+		txID := txID
+
+		delete(s.addedTxBlockIDs, txID)
+		if err := database.PutID(s.txBlockDB, txID[:], blkID); err != nil {
+			return fmt.Errorf("failed to add tx blockID: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeMetadata() error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, timestampKey, s.timestamp); err != nil {
@@ -506,6 +718,211 @@ func (s *state) Checksums() (ids.ID, ids.ID) {
 	return s.txChecksum, s.utxoState.Checksum()
 }
 
+// indexAssets populates the asset index if it was never populated. This is
+// required for databases that were created before the index existed.
+func (s *state) indexAssets() error {
+	indexed, err := s.singletonDB.Has(assetsIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	unorderedAssetIDs := set.Set[ids.ID]{}
+	txIt := s.txDB.NewIterator()
+	defer txIt.Release()
+
+	for txIt.Next() {
+		tx, err := s.parser.ParseGenesisTx(txIt.Value())
+		if err != nil {
+			return err
+		}
+		if _, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+			unorderedAssetIDs.Add(tx.ID())
+		}
+	}
+	if err := txIt.Error(); err != nil {
+		return err
+	}
+
+	// Assets that were accepted in blocks are ordered by their position in
+	// the chain.
+	type blockAsset struct {
+		height  uint64
+		index   int
+		assetID ids.ID
+	}
+	var blockAssets []blockAsset
+	for assetID := range unorderedAssetIDs {
+		blkID, err := s.GetTxBlockID(assetID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		blk, err := s.GetBlock(blkID)
+		if err != nil {
+			return err
+		}
+		index := slices.IndexFunc(blk.Txs(), func(tx *txs.Tx) bool {
+			return tx.ID() == assetID
+		})
+		blockAssets = append(blockAssets, blockAsset{
+			height:  blk.Height(),
+			index:   index,
+			assetID: assetID,
+		})
+	}
+	slices.SortFunc(blockAssets, func(a, b blockAsset) int {
+		if c := cmp.Compare(a.height, b.height); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.index, b.index)
+	})
+
+	orderedAssetIDs := make([]ids.ID, len(blockAssets))
+	for i, blockAsset := range blockAssets {
+		unorderedAssetIDs.Remove(blockAsset.assetID)
+		orderedAssetIDs[i] = blockAsset.assetID
+	}
+
+	assetIDs := unorderedAssetIDs.List()
+	utils.Sort(assetIDs)
+	assetIDs = append(assetIDs, orderedAssetIDs...)
+	for i, assetID := range assetIDs {
+		indexKey := database.PackUInt64(uint64(i))
+		if err := database.PutID(s.assetDB, indexKey, assetID); err != nil {
+			return fmt.Errorf("failed to add assetID: %w", err)
+		}
+	}
+	s.numAssets = uint64(len(assetIDs))
+
+	if err := database.PutUInt64(s.singletonDB, numAssetsKey, s.numAssets); err != nil {
+		return fmt.Errorf("failed to write number of assets: %w", err)
+	}
+	if err := s.singletonDB.Put(assetsIndexedKey, nil); err != nil {
+		return err
+	}
+	return s.db.Commit()
+}
+
+// indexAssetNames populates the asset name index from the asset index if it
+// was never populated. This is required for databases that were created before
+// the index existed.
+func (s *state) indexAssetNames() error {
+	indexed, err := s.singletonDB.Has(assetNamesIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	assetIt := s.assetDB.NewIterator()
+	defer assetIt.Release()
+
+	for assetIt.Next() {
+		index, err := database.ParseUInt64(assetIt.Key())
+		if err != nil {
+			return err
+		}
+		assetID, err := ids.ToID(assetIt.Value())
+		if err != nil {
+			return err
+		}
+		if err := s.putAssetNames(assetID, index); err != nil {
+			return err
+		}
+	}
+	if err := assetIt.Error(); err != nil {
+		return err
+	}
+
+	if err := s.singletonDB.Put(assetNamesIndexedKey, nil); err != nil {
+		return err
+	}
+	return s.db.Commit()
+}
+
+// putAssetNames indexes [assetID], which is at [index] in the asset index, by
+// both its symbol and its name.
+func (s *state) putAssetNames(assetID ids.ID, index uint64) error {
+	symbol, name, err := s.getAssetNames(assetID)
+	if err != nil {
+		return err
+	}
+	indexBytes := database.PackUInt64(index)
+	for _, key := range []string{symbol, name} {
+		if err := s.assetNameDB.Put(assetNameKey(key, assetID), indexBytes); err != nil {
+			return fmt.Errorf("failed to add asset name: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) getAssetNames(assetID ids.ID) (string, string, error) {
+	tx, err := s.GetTx(assetID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get asset %s: %w", assetID, err)
+	}
+	createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", errTxNotCreateAsset, assetID)
+	}
+	return createAssetTx.Symbol, createAssetTx.Name, nil
+}
+
+// assetNameKey returns the key of [assetID] in the asset name index under
+// [name]. The name is hashed so that the keys of a name are never a prefix of
+// the keys of a different name.
+func assetNameKey(name string, assetID ids.ID) []byte {
+	key := make([]byte, hashing.HashLen+ids.IDLen)
+	copy(key, hashing.ComputeHash256([]byte(name)))
+	copy(key[hashing.HashLen:], assetID[:])
+	return key
+}
+
+// indexTxBlocks populates the tx block index if it was never populated. This is
+// required for databases that were created before the index existed.
+func (s *state) indexTxBlocks() error {
+	indexed, err := s.singletonDB.Has(txBlocksIndexedKey)
+	if err != nil || indexed {
+		return err
+	}
+
+	for height := uint64(0); ; height++ {
+		blkID, err := s.GetBlockIDAtHeight(height)
+		if err == database.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		blk, err := s.GetBlock(blkID)
+		if err != nil {
+			return err
+		}
+		for _, tx := range blk.Txs() {
+			txID := tx.ID()
+			if err := database.PutID(s.txBlockDB, txID[:], blkID); err != nil {
+				return fmt.Errorf("failed to add tx blockID: %w", err)
+			}
+		}
+
+		// Writes are buffered in memory until they are committed, so they are
+		// committed periodically. If the node stops before the index is
+		// completed, it is populated again on the next startup.
+		if height%txBlocksIndexCommitInterval == 0 {
+			if err := s.db.Commit(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.singletonDB.Put(txBlocksIndexedKey, nil); err != nil {
+		return err
+	}
+	return s.db.Commit()
+}
+
 func (s *state) initTxChecksum() error {
 	if !s.trackChecksum {
 		return nil
@@ -12,9 +12,11 @@ import (
 
 	"github.com/CaiJiJi/avalanchego/database"
 	"github.com/CaiJiJi/avalanchego/database/memdb"
+	"github.com/CaiJiJi/avalanchego/database/prefixdb"
 	"github.com/CaiJiJi/avalanchego/database/versiondb"
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/upgrade"
+	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/vms/avm/block"
 	"github.com/CaiJiJi/avalanchego/vms/avm/fxs"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
@@ -316,3 +318,264 @@ func TestInitializeChainState(t *testing.T) {
 	require.NoError(err)
 	require.Equal(genesis.ID(), lastAccepted.Parent())
 }
+
+func TestAssetIDs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	newCreateAssetTx := func(symbol string) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				BlockchainID: ids.GenerateTestID(),
+			}},
+			Name:   "asset " + symbol,
+			Symbol: symbol,
+		}}
+		require.NoError(tx.Initialize(parser.Codec()))
+		return tx
+	}
+
+	assetTx0 := newCreateAssetTx("A")
+	assetTx1 := newCreateAssetTx("B")
+	assetTx2 := newCreateAssetTx("C")
+
+	s.AddTx(assetTx0)
+	s.AddTx(populatedTx) // not an asset
+	s.AddTx(assetTx1)
+	require.NoError(s.Commit())
+
+	// Pending assets are returned after the committed ones
+	s.AddTx(assetTx2)
+
+	assetIDs, err := s.AssetIDs(0, 10)
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx0.ID(), assetTx1.ID(), assetTx2.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDs(1, 1)
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx1.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDs(2, 10)
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx2.ID()}, assetIDs)
+
+	require.NoError(s.Commit())
+
+	// The index should be persisted across restarts
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	assetIDs, err = s.AssetIDs(1, 10)
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx1.ID(), assetTx2.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDs(3, 10)
+	require.NoError(err)
+	require.Empty(assetIDs)
+}
+
+func TestAssetIDsByName(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	newCreateAssetTx := func(name, symbol string) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				BlockchainID: ids.GenerateTestID(),
+			}},
+			Name:   name,
+			Symbol: symbol,
+		}}
+		require.NoError(tx.Initialize(parser.Codec()))
+		return tx
+	}
+
+	assetTx0 := newCreateAssetTx("Token", "TKN")
+	assetTx1 := newCreateAssetTx("TKN", "TKN") // symbol and name match
+	assetTx2 := newCreateAssetTx("Other", "TK")
+	assetTx3 := newCreateAssetTx("Token", "TOK")
+
+	s.AddTx(assetTx0)
+	s.AddTx(assetTx1)
+	s.AddTx(assetTx2)
+	require.NoError(s.Commit())
+
+	// Pending assets are returned after the committed ones
+	s.AddTx(assetTx3)
+
+	assetIDs, err := s.AssetIDsByName("Token")
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx0.ID(), assetTx3.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDsByName("TKN")
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx0.ID(), assetTx1.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDsByName("TK")
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx2.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDsByName("T")
+	require.NoError(err)
+	require.Empty(assetIDs)
+
+	require.NoError(s.Commit())
+
+	// The index should be persisted across restarts
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	assetIDs, err = s.AssetIDsByName("Token")
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx0.ID(), assetTx3.ID()}, assetIDs)
+}
+
+func TestIndexAssets(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	newCreateAssetTx := func(symbol string) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				BlockchainID: ids.GenerateTestID(),
+			}},
+			Name:   "asset " + symbol,
+			Symbol: symbol,
+		}}
+		require.NoError(tx.Initialize(parser.Codec()))
+		return tx
+	}
+
+	// Assets that were accepted before the chain was linearized.
+	unorderedAssetTx0 := newCreateAssetTx("A")
+	unorderedAssetTx1 := newCreateAssetTx("B")
+	s.AddTx(unorderedAssetTx0)
+	s.AddTx(unorderedAssetTx1)
+	require.NoError(s.InitializeChainState(ids.GenerateTestID(), upgrade.InitiallyActiveTime))
+
+	// Assets that were accepted in blocks.
+	assetTx0 := newCreateAssetTx("C")
+	assetTx1 := newCreateAssetTx("D")
+	genesis, err := s.GetBlock(s.GetLastAccepted())
+	require.NoError(err)
+	blk0, err := block.NewStandardBlock(
+		genesis.ID(),
+		genesis.Height()+1,
+		upgrade.InitiallyActiveTime,
+		[]*txs.Tx{assetTx1, populatedTx},
+		parser.Codec(),
+	)
+	require.NoError(err)
+	blk1, err := block.NewStandardBlock(
+		blk0.ID(),
+		blk0.Height()+1,
+		upgrade.InitiallyActiveTime,
+		[]*txs.Tx{assetTx0},
+		parser.Codec(),
+	)
+	require.NoError(err)
+	for _, blk := range []block.Block{blk0, blk1} {
+		for _, tx := range blk.Txs() {
+			s.AddTx(tx)
+		}
+		s.AddBlock(blk)
+		s.SetLastAccepted(blk.ID())
+	}
+	require.NoError(s.Commit())
+
+	// Simulate a database that was populated before the asset index existed.
+	singletonDB := prefixdb.New(singletonPrefix, vdb)
+	require.NoError(singletonDB.Delete(assetsIndexedKey))
+	require.NoError(singletonDB.Delete(numAssetsKey))
+	require.NoError(singletonDB.Delete(assetNamesIndexedKey))
+	assetNameDB := prefixdb.New(assetNamePrefix, vdb)
+	it := assetNameDB.NewIterator()
+	for it.Next() {
+		require.NoError(assetNameDB.Delete(it.Key()))
+	}
+	require.NoError(it.Error())
+	it.Release()
+	require.NoError(vdb.Commit())
+
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	unorderedAssetIDs := []ids.ID{unorderedAssetTx0.ID(), unorderedAssetTx1.ID()}
+	utils.Sort(unorderedAssetIDs)
+	expectedAssetIDs := append(unorderedAssetIDs, assetTx1.ID(), assetTx0.ID())
+
+	assetIDs, err := s.AssetIDs(0, 10)
+	require.NoError(err)
+	require.Equal(expectedAssetIDs, assetIDs)
+
+	assetIDs, err = s.AssetIDsByName("D")
+	require.NoError(err)
+	require.Equal([]ids.ID{assetTx1.ID()}, assetIDs)
+
+	assetIDs, err = s.AssetIDsByName("asset A")
+	require.NoError(err)
+	require.Equal([]ids.ID{unorderedAssetTx0.ID()}, assetIDs)
+
+	// The index should only be populated once.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	assetIDs, err = s.AssetIDs(0, 10)
+	require.NoError(err)
+	require.Equal(expectedAssetIDs, assetIDs)
+}
+
+func TestIndexTxBlocks(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	require.NoError(s.InitializeChainState(ids.GenerateTestID(), upgrade.InitiallyActiveTime))
+	genesis, err := s.GetBlock(s.GetLastAccepted())
+	require.NoError(err)
+	blk, err := block.NewStandardBlock(
+		genesis.ID(),
+		genesis.Height()+1,
+		upgrade.InitiallyActiveTime,
+		[]*txs.Tx{populatedTx},
+		parser.Codec(),
+	)
+	require.NoError(err)
+	s.AddTx(populatedTx)
+	s.AddBlock(blk)
+	s.SetLastAccepted(blk.ID())
+	require.NoError(s.Commit())
+
+	// Simulate a database that was populated before the tx block index
+	// existed.
+	singletonDB := prefixdb.New(singletonPrefix, vdb)
+	require.NoError(singletonDB.Delete(txBlocksIndexedKey))
+	txBlockDB := prefixdb.New(txBlockPrefix, vdb)
+	require.NoError(txBlockDB.Delete(populatedTxID[:]))
+	require.NoError(vdb.Commit())
+
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	blkID, err := s.GetTxBlockID(populatedTxID)
+	require.NoError(err)
+	require.Equal(blk.ID(), blkID)
+
+	_, err = s.GetTxBlockID(ids.GenerateTestID())
+	require.ErrorIs(err, database.ErrNotFound)
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"errors"
+
+	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
+	"github.com/CaiJiJi/avalanchego/vms/components/avax"
+	"github.com/CaiJiJi/avalanchego/vms/components/verify"
+	"github.com/CaiJiJi/avalanchego/vms/nftfx"
+	"github.com/CaiJiJi/avalanchego/vms/propertyfx"
+	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ txs.Visitor = (*credsBuilder)(nil)
+
+	errUnsupportedInputType      = errors.New("unsupported input type")
+	errUnsupportedOpType         = errors.New("unsupported operation type")
+	errUnsupportedCredentialType = errors.New("unsupported credential type")
+)
+
+// credsBuilder creates an empty credential of the fx of each input and
+// operation of a tx, in the order the tx's credentials are expected.
+type credsBuilder struct {
+	creds []verify.Verifiable
+}
+
+// newCreds returns an empty credential for each credential of [tx].
+func newCreds(tx txs.UnsignedTx) ([]verify.Verifiable, error) {
+	b := &credsBuilder{}
+	if err := tx.Visit(b); err != nil {
+		return nil, err
+	}
+	return b.creds, nil
+}
+
+// secp256k1Credential returns the secp256k1fx credential that holds the
+// signatures of [cred].
+func secp256k1Credential(cred verify.Verifiable) (*secp256k1fx.Credential, error) {
+	switch cred := cred.(type) {
+	case *secp256k1fx.Credential:
+		return cred, nil
+	case *nftfx.Credential:
+		return &cred.Credential, nil
+	case *propertyfx.Credential:
+		return &cred.Credential, nil
+	default:
+		return nil, errUnsupportedCredentialType
+	}
+}
+
+func (b *credsBuilder) BaseTx(tx *txs.BaseTx) error {
+	return b.addIns(tx.Ins)
+}
+
+func (b *credsBuilder) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return b.addIns(tx.Ins)
+}
+
+func (b *credsBuilder) OperationTx(tx *txs.OperationTx) error {
+	if err := b.addIns(tx.Ins); err != nil {
+		return err
+	}
+	for _, op := range tx.Ops {
+		switch op.Op.(type) {
+		case *secp256k1fx.MintOperation:
+			b.creds = append(b.creds, &secp256k1fx.Credential{})
+		case *nftfx.MintOperation, *nftfx.TransferOperation:
+			b.creds = append(b.creds, &nftfx.Credential{})
+		case *propertyfx.MintOperation, *propertyfx.BurnOperation:
+			b.creds = append(b.creds, &propertyfx.Credential{})
+		default:
+			return errUnsupportedOpType
+		}
+	}
+	return nil
+}
+
+func (b *credsBuilder) ImportTx(tx *txs.ImportTx) error {
+	if err := b.addIns(tx.Ins); err != nil {
+		return err
+	}
+	return b.addIns(tx.ImportedIns)
+}
+
+func (b *credsBuilder) ExportTx(tx *txs.ExportTx) error {
+	return b.addIns(tx.Ins)
+}
+
+func (b *credsBuilder) addIns(ins []*avax.TransferableInput) error {
+	for _, in := range ins {
+		if _, ok := in.In.(*secp256k1fx.TransferInput); !ok {
+			return errUnsupportedInputType
+		}
+		b.creds = append(b.creds, &secp256k1fx.Credential{})
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
+	"github.com/CaiJiJi/avalanchego/vms/components/avax"
+
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
+)
+
+var _ txs.Visitor = (*burnedAmountCalculator)(nil)
+
+// burnedAmount returns the amount of [assetID] that [tx] consumes but doesn't
+// produce.
+func burnedAmount(tx *txs.Tx, assetID ids.ID) (uint64, error) {
+	c := &burnedAmountCalculator{
+		assetID: assetID,
+	}
+	if err := tx.Unsigned.Visit(c); err != nil {
+		return 0, err
+	}
+	return safemath.Sub(c.consumed, c.produced)
+}
+
+type burnedAmountCalculator struct {
+	assetID  ids.ID
+	consumed uint64
+	produced uint64
+}
+
+func (c *burnedAmountCalculator) BaseTx(tx *txs.BaseTx) error {
+	if err := c.consume(tx.Ins); err != nil {
+		return err
+	}
+	return c.produce(tx.Outs)
+}
+
+func (c *burnedAmountCalculator) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *burnedAmountCalculator) OperationTx(tx *txs.OperationTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *burnedAmountCalculator) ImportTx(tx *txs.ImportTx) error {
+	if err := c.BaseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return c.consume(tx.ImportedIns)
+}
+
+func (c *burnedAmountCalculator) ExportTx(tx *txs.ExportTx) error {
+	if err := c.BaseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return c.produce(tx.ExportedOuts)
+}
+
+func (c *burnedAmountCalculator) consume(ins []*avax.TransferableInput) error {
+	for _, in := range ins {
+		if in.AssetID() != c.assetID {
+			continue
+		}
+		consumed, err := safemath.Add(c.consumed, in.In.Amount())
+		if err != nil {
+			return err
+		}
+		c.consumed = consumed
+	}
+	return nil
+}
+
+func (c *burnedAmountCalculator) produce(outs []*avax.TransferableOutput) error {
+	for _, out := range outs {
+		if out.AssetID() != c.assetID {
+			continue
+		}
+		produced, err := safemath.Add(c.produced, out.Out.Amount())
+		if err != nil {
+			return err
+		}
+		c.produced = produced
+	}
+	return nil
+}
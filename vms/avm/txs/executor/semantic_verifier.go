@@ -9,6 +9,7 @@ import (
 	"reflect"
 
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/vms/avm/state"
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
@@ -56,6 +57,19 @@ func (v *SemanticVerifier) BaseTx(tx *txs.BaseTx) error {
 }
 
 func (v *SemanticVerifier) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	// The initial states are verified to be sorted and unique during syntactic
+	// verification. Because the asset's state is derived from their order,
+	// this is re-verified here so that a tx that bypassed, or was accepted by
+	// a different version of, syntactic verification can't be executed.
+	for _, state := range tx.States {
+		if err := state.Verify(v.Codec, len(v.Fxs)); err != nil {
+			return err
+		}
+	}
+	if !utils.IsSortedAndUnique(tx.States) {
+		return errInitialStatesNotSortedUnique
+	}
+
 	return v.BaseTx(&tx.BaseTx)
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/avm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
 	"github.com/CaiJiJi/avalanchego/vms/components/verify"
+	"github.com/CaiJiJi/avalanchego/vms/nftfx"
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
 )
 
@@ -1120,3 +1121,97 @@ func TestSemanticVerifierImportTx(t *testing.T) {
 		})
 	}
 }
+
+func TestSemanticVerifierCreateAssetTx(t *testing.T) {
+	ctx := snowtest.Context(t, snowtest.XChainID)
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.Fx{}
+	nftFx := &nftfx.Fx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+			nftFx,
+		},
+	)
+	require.NoError(t, err)
+
+	backend := &Backend{
+		Ctx:    ctx,
+		Config: &feeConfig,
+		Fxs: []*fxs.ParsedFx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: secpFx,
+			},
+			{
+				ID: nftfx.ID,
+				Fx: nftFx,
+			},
+		},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         parser.Codec(),
+		FeeAssetID:    ids.GenerateTestID(),
+		Bootstrapped:  true,
+	}
+
+	tests := []struct {
+		name   string
+		states []*txs.InitialState
+		err    error
+	}{
+		{
+			name: "sorted and unique",
+			states: []*txs.InitialState{
+				{FxIndex: 0},
+				{FxIndex: 1},
+			},
+			err: nil,
+		},
+		{
+			name: "not sorted",
+			states: []*txs.InitialState{
+				{FxIndex: 1},
+				{FxIndex: 0},
+			},
+			err: errInitialStatesNotSortedUnique,
+		},
+		{
+			name: "not unique",
+			states: []*txs.InitialState{
+				{FxIndex: 0},
+				{FxIndex: 0},
+			},
+			err: errInitialStatesNotSortedUnique,
+		},
+		{
+			name: "unknown fx",
+			states: []*txs.InitialState{
+				{FxIndex: 2},
+			},
+			err: txs.ErrUnknownFx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// Syntactic verification is bypassed, so the semantic verifier
+			// is the only check of the initial states.
+			tx := &txs.Tx{
+				Unsigned: &txs.CreateAssetTx{
+					States: test.states,
+				},
+			}
+			err := tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state.NewMockChain(ctrl),
+				Tx:      tx,
+			})
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
@@ -135,6 +135,29 @@ func (b *Builder) MintFTs(
 	return signer.SignUnsigned(context.Background(), xSigner, utx)
 }
 
+func (b *Builder) MintProperty(
+	assetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	kc *secp256k1fx.Keychain,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	xBuilder, xSigner := b.builders(kc)
+
+	utx, err := xBuilder.NewOperationTxMintProperty(
+		assetID,
+		owner,
+		common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{changeAddr},
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed minting property: %w", err)
+	}
+
+	return signer.SignUnsigned(context.Background(), xSigner, utx)
+}
+
 func (b *Builder) Operation(
 	ops []*txs.Operation,
 	kc *secp256k1fx.Keychain,
@@ -188,19 +211,33 @@ func (b *Builder) ExportTx(
 	kc *secp256k1fx.Keychain,
 	changeAddr ids.ShortID,
 ) (*txs.Tx, error) {
-	xBuilder, xSigner := b.builders(kc)
-
-	outputs := []*avax.TransferableOutput{{
-		Asset: avax.Asset{ID: exportedAssetID},
-		Out: &secp256k1fx.TransferOutput{
-			Amt: exportedAmt,
-			OutputOwners: secp256k1fx.OutputOwners{
-				Locktime:  0,
-				Threshold: 1,
-				Addrs:     []ids.ShortID{to},
+	return b.MultiExportTx(
+		destinationChain,
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: exportedAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: exportedAmt,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  0,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{to},
+				},
 			},
-		},
-	}}
+		}},
+		kc,
+		changeAddr,
+	)
+}
+
+// MultiExportTx exports all of the provided [outputs] to [destinationChain]
+// in a single tx.
+func (b *Builder) MultiExportTx(
+	destinationChain ids.ID,
+	outputs []*avax.TransferableOutput,
+	kc *secp256k1fx.Keychain,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	xBuilder, xSigner := b.builders(kc)
 
 	utx, err := xBuilder.NewExportTx(
 		destinationChain,
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"github.com/prometheus/client_golang/prometheus"
@@ -18,6 +19,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/api/metrics"
 	"github.com/CaiJiJi/avalanchego/cache"
 	"github.com/CaiJiJi/avalanchego/database"
+	"github.com/CaiJiJi/avalanchego/database/prefixdb"
 	"github.com/CaiJiJi/avalanchego/database/versiondb"
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/pubsub"
@@ -43,6 +45,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
 	"github.com/CaiJiJi/avalanchego/vms/txs/mempool"
 
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
 	blockbuilder "github.com/CaiJiJi/avalanchego/vms/avm/block/builder"
 	blockexecutor "github.com/CaiJiJi/avalanchego/vms/avm/block/executor"
 	extensions "github.com/CaiJiJi/avalanchego/vms/avm/fxs"
@@ -57,6 +60,8 @@ var (
 	errIncompatibleFx            = errors.New("incompatible feature extension")
 	errUnknownFx                 = errors.New("unknown feature extension")
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
+	errInsufficientFeeBump       = errors.New("insufficient fee bump")
+	errTxTooLarge                = errors.New("tx too large")
 
 	_ vertex.LinearizableVMWithEngine = (*VM)(nil)
 )
@@ -97,6 +102,9 @@ type VM struct {
 	// asset id that will be used for fees
 	feeAssetID ids.ID
 
+	// assets created at genesis, in the order they were defined
+	genesisTxs []*txs.Tx
+
 	// Asset ID --> Bit set with fx IDs the asset supports
 	assetToFxCache *cache.LRU[ids.ID, set.Bits64]
 
@@ -109,6 +117,8 @@ type VM struct {
 	walletService WalletService
 
 	addressTxsIndexer index.AddressTxsIndexer
+	// memoIndex is nil if memo indexing is disabled
+	memoIndex *memoIndex
 
 	txBackend *txexecutor.Backend
 
@@ -119,9 +129,19 @@ type VM struct {
 	awaitShutdown       sync.WaitGroup
 
 	networkConfig network.Config
+	// Maximum amount of time a GetUTXOs call may scan for UTXOs
+	getUTXOsDeadline time.Duration
+	// Minimum percentage by which a replacement tx must outbid the txs it
+	// replaces
+	replaceTxMinFeeBumpPercentage uint64
+	// Whether ExportAllKeys may be called
+	exportAllKeysEnabled bool
+	// Maximum size of a tx submitted through the API
+	maxTxSize int
 	// These values are only initialized after the chain has been linearized.
 	blockbuilder.Builder
 	chainManager blockexecutor.Manager
+	mempool      xmempool.Mempool
 	network      *network.Network
 }
 
@@ -261,6 +281,12 @@ func (vm *VM) Initialize(
 			return fmt.Errorf("failed to initialize disabled indexer: %w", err)
 		}
 	}
+	if avmConfig.IndexMemos {
+		vm.ctx.Log.Info("memo indexing is enabled")
+		vm.memoIndex = &memoIndex{
+			db: prefixdb.New(memoIndexPrefix, vm.db),
+		}
+	}
 
 	vm.txBackend = &txexecutor.Backend{
 		Ctx:           ctx,
@@ -274,6 +300,10 @@ func (vm *VM) Initialize(
 
 	vm.onShutdownCtx, vm.onShutdownCtxCancel = context.WithCancel(context.Background())
 	vm.networkConfig = avmConfig.Network
+	vm.getUTXOsDeadline = avmConfig.GetUTXOsDeadline
+	vm.replaceTxMinFeeBumpPercentage = avmConfig.ReplaceTxMinFeeBumpPercentage
+	vm.exportAllKeysEnabled = avmConfig.ExportAllKeysEnabled
+	vm.maxTxSize = avmConfig.MaxTxSize
 	return vm.state.Commit()
 }
 
@@ -405,6 +435,7 @@ func (vm *VM) Linearize(ctx context.Context, stopVertexID ids.ID, toEngine chan<
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
+	vm.mempool = mempool
 
 	vm.chainManager = blockexecutor.NewManager(
 		mempool,
@@ -498,6 +529,19 @@ func (vm *VM) ParseTx(_ context.Context, bytes []byte) (snowstorm.Tx, error) {
  ******************************************************************************
  */
 
+// parseTxFromRPC parses a tx submitted through the API. Txs larger than
+// [vm.maxTxSize] are rejected without being parsed.
+func (vm *VM) parseTxFromRPC(txBytes []byte) (*txs.Tx, error) {
+	if len(txBytes) > vm.maxTxSize {
+		return nil, fmt.Errorf("%w: %d bytes exceeds the maximum of %d bytes",
+			errTxTooLarge,
+			len(txBytes),
+			vm.maxTxSize,
+		)
+	}
+	return vm.parser.ParseTx(txBytes)
+}
+
 // issueTxFromRPC attempts to send a transaction to consensus.
 //
 // Invariant: The context lock is not held
@@ -515,6 +559,60 @@ func (vm *VM) issueTxFromRPC(tx *txs.Tx) (ids.ID, error) {
 	return txID, nil
 }
 
+// replaceTxFromRPC attempts to add [tx] to the mempool in place of the
+// mempool txs that consume any of the same inputs. The fee burned by [tx] must
+// exceed the combined fees of the replaced txs by at least
+// [vm.replaceTxMinFeeBumpPercentage].
+//
+// Invariant: The context lock is not held.
+func (vm *VM) replaceTxFromRPC(tx *txs.Tx) (ids.ID, error) {
+	txID := tx.ID()
+	fee, err := burnedAmount(tx, vm.feeAssetID)
+	if err != nil {
+		return txID, err
+	}
+
+	err = vm.network.ReplaceTxFromRPC(tx, func(conflicts []*txs.Tx) error {
+		var replacedFee uint64
+		for _, conflict := range conflicts {
+			conflictFee, err := burnedAmount(conflict, vm.feeAssetID)
+			if err != nil {
+				return err
+			}
+			replacedFee, err = safemath.Add(replacedFee, conflictFee)
+			if err != nil {
+				return err
+			}
+		}
+
+		bump, err := safemath.Mul(replacedFee, vm.replaceTxMinFeeBumpPercentage)
+		if err != nil {
+			return err
+		}
+		minFee, err := safemath.Add(replacedFee, bump/100)
+		if err != nil {
+			return err
+		}
+		if fee <= replacedFee || fee < minFee {
+			return fmt.Errorf("%w: fee %d must exceed the replaced fee %d by at least %d%%",
+				errInsufficientFeeBump,
+				fee,
+				replacedFee,
+				vm.replaceTxMinFeeBumpPercentage,
+			)
+		}
+		return nil
+	})
+	if err != nil {
+		vm.ctx.Log.Debug("failed to replace txs in mempool",
+			zap.Stringer("txID", txID),
+			zap.Error(err),
+		)
+		return txID, err
+	}
+	return txID, nil
+}
+
 /*
  ******************************************************************************
  ********************************** Helpers ***********************************
@@ -535,6 +633,7 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 
 	// secure this by defaulting to avaxAsset
 	vm.feeAssetID = vm.ctx.AVAXAssetID
+	vm.genesisTxs = make([]*txs.Tx, 0, len(genesis.Txs))
 
 	for index, genesisTx := range genesis.Txs {
 		if len(genesisTx.Outs) != 0 {
@@ -552,6 +651,7 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 		if err := vm.Alias(txID, genesisTx.Alias); err != nil {
 			return err
 		}
+		vm.genesisTxs = append(vm.genesisTxs, tx)
 
 		if !stateInitialized {
 			vm.initState(tx)
@@ -680,6 +780,11 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 	if err := vm.addressTxsIndexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
+	if vm.memoIndex != nil {
+		if err := vm.memoIndex.Accept(tx); err != nil {
+			return fmt.Errorf("error indexing tx memo: %w", err)
+		}
+	}
 
 	vm.pubsub.Publish(NewPubSubFilterer(tx))
 	vm.walletService.decided(txID)
@@ -284,6 +284,74 @@ func TestIssueProperty(t *testing.T) {
 	issueAndAccept(require, env.vm, env.issuer, burnPropertyTx)
 }
 
+// Test minting a property with the tx builder
+func TestIssueMintProperty(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	env.vm.ctx.Lock.Unlock()
+
+	var (
+		key = keys[0]
+		kc  = secp256k1fx.NewKeychain(key)
+	)
+
+	initialStates := map[uint32][]verify.State{
+		2: {
+			&propertyfx.MintOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.Address()},
+				},
+			},
+		},
+	}
+
+	createAssetTx, err := env.txBuilder.CreateAssetTx(
+		"Team Rocket", // name
+		"TR",          // symbol
+		0,             // denomination
+		initialStates,
+		kc,
+		key.Address(),
+	)
+	require.NoError(err)
+	issueAndAccept(require, env.vm, env.issuer, createAssetTx)
+
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[1].Address()},
+	}
+	mintPropertyTx, err := env.txBuilder.MintProperty(
+		createAssetTx.ID(),
+		owner,
+		kc,
+		key.Address(),
+	)
+	require.NoError(err)
+
+	require.IsType(&txs.OperationTx{}, mintPropertyTx.Unsigned)
+	utx := mintPropertyTx.Unsigned.(*txs.OperationTx)
+	require.Len(utx.Ops, 1)
+	require.IsType(&propertyfx.MintOperation{}, utx.Ops[0].Op)
+	mintOp := utx.Ops[0].Op.(*propertyfx.MintOperation)
+	// The builder initializes the context of the owners, so only the exported
+	// fields are compared.
+	ownedOutput := mintOp.OwnedOutput.OutputOwners
+	require.Equal(owner.Locktime, ownedOutput.Locktime)
+	require.Equal(owner.Threshold, ownedOutput.Threshold)
+	require.Equal(owner.Addrs, ownedOutput.Addrs)
+
+	// Issuing the tx performs syntactic and semantic verification.
+	issueAndAccept(require, env.vm, env.issuer, mintPropertyTx)
+}
+
 func TestIssueTxWithFeeAsset(t *testing.T) {
 	require := require.New(t)
 
@@ -658,6 +726,84 @@ func TestIssueExportTx(t *testing.T) {
 	require.Len(utxoBytes, 1)
 }
 
+func TestIssueMultiExportTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork:             latest,
+		isCustomFeeAsset: true,
+	})
+	defer env.vm.ctx.Lock.Unlock()
+
+	var (
+		key        = keys[0]
+		kc         = secp256k1fx.NewKeychain(key)
+		changeAddr = key.PublicKey().Address()
+		to0        = keys[1].PublicKey().Address()
+		to1        = keys[2].PublicKey().Address()
+
+		feeAssetCreateTx = getCreateTxFromGenesisTest(t, env.genesisBytes, feeAssetName)
+		createTx         = getCreateTxFromGenesisTest(t, env.genesisBytes, otherAssetName)
+	)
+
+	exportedOuts := []*avax.TransferableOutput{
+		{ // fee asset
+			Asset: avax.Asset{ID: feeAssetCreateTx.ID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: startBalance - env.vm.TxFee,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{to0},
+				},
+			},
+		},
+		{ // other asset
+			Asset: avax.Asset{ID: createTx.ID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: startBalance,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{to1},
+				},
+			},
+		},
+	}
+
+	tx, err := env.txBuilder.MultiExportTx(
+		constants.PlatformChainID,
+		exportedOuts,
+		kc,
+		changeAddr,
+	)
+	require.NoError(err)
+
+	require.IsType(&txs.ExportTx{}, tx.Unsigned)
+	exportTx := tx.Unsigned.(*txs.ExportTx)
+	require.Equal(constants.PlatformChainID, exportTx.DestinationChain)
+	require.ElementsMatch(exportedOuts, exportTx.ExportedOuts)
+
+	env.vm.ctx.Lock.Unlock()
+
+	issueAndAccept(require, env.vm, env.issuer, tx)
+
+	env.vm.ctx.Lock.Lock()
+
+	peerSharedMemory := env.sharedMemory.NewSharedMemory(constants.PlatformChainID)
+	for _, to := range []ids.ShortID{to0, to1} {
+		utxoBytes, _, _, err := peerSharedMemory.Indexed(
+			env.vm.ctx.ChainID,
+			[][]byte{
+				to.Bytes(),
+			},
+			nil,
+			nil,
+			math.MaxInt32,
+		)
+		require.NoError(err)
+		require.Len(utxoBytes, 1)
+	}
+}
+
 func TestClearForceAcceptedExportTx(t *testing.T) {
 	require := require.New(t)
 
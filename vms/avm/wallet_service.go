@@ -140,7 +140,7 @@ func (w *WalletService) IssueTx(_ *http.Request, args *api.FormattedTx, reply *a
 		return fmt.Errorf("problem decoding transaction: %w", err)
 	}
 
-	tx, err := w.vm.parser.ParseTx(txBytes)
+	tx, err := w.vm.parseTxFromRPC(txBytes)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/utils"
@@ -65,6 +66,33 @@ func GetPaginatedUTXOs(
 	lastUTXOID ids.ID,
 	limit int,
 ) ([]*UTXO, ids.ShortID, ids.ID, error) {
+	utxos, lastAddr, lastUTXOID, _, err := GetPaginatedUTXOsWithDeadline(
+		db,
+		addrs,
+		lastAddr,
+		lastUTXOID,
+		limit,
+		time.Time{},
+	)
+	return utxos, lastAddr, lastUTXOID, err
+}
+
+// GetPaginatedUTXOsWithDeadline is GetPaginatedUTXOs, except that the scan
+// stops early once [deadline] has passed. The returned address and UTXO ID can
+// be used to continue the scan regardless of whether it stopped early.
+//
+// At least one UTXO is fetched before [deadline] is honored, so that repeated
+// calls always make progress. The zero time never passes.
+//
+// Returns true if the scan stopped early because of [deadline].
+func GetPaginatedUTXOsWithDeadline(
+	db UTXOReader,
+	addrs set.Set[ids.ShortID],
+	lastAddr ids.ShortID,
+	lastUTXOID ids.ID,
+	limit int,
+	deadline time.Time,
+) ([]*UTXO, ids.ShortID, ids.ID, bool, error) {
 	var (
 		utxos      []*UTXO
 		seen       set.Set[ids.ID] // IDs of UTXOs already in the list
@@ -84,7 +112,7 @@ func GetPaginatedUTXOs(
 
 		utxoIDs, err := db.UTXOIDs(addr.Bytes(), start, searchSize) // Get UTXOs associated with [addr]
 		if err != nil {
-			return nil, ids.ShortID{}, ids.Empty, fmt.Errorf("couldn't get UTXOs for address %s: %w", addr, err)
+			return nil, ids.ShortID{}, ids.Empty, false, fmt.Errorf("couldn't get UTXOs for address %s: %w", addr, err)
 		}
 		for _, utxoID := range utxoIDs {
 			lastUTXOID = utxoID // The last searched UTXO - not the last found
@@ -95,16 +123,19 @@ func GetPaginatedUTXOs(
 
 			utxo, err := db.GetUTXO(utxoID)
 			if err != nil {
-				return nil, ids.ShortID{}, ids.Empty, fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
+				return nil, ids.ShortID{}, ids.Empty, false, fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
 			}
 
 			utxos = append(utxos, utxo)
 			seen.Add(utxoID)
 			limit--
 			if limit <= 0 {
-				return utxos, lastAddr, lastUTXOID, nil // Found [limit] utxos; stop.
+				return utxos, lastAddr, lastUTXOID, false, nil // Found [limit] utxos; stop.
+			}
+			if !deadline.IsZero() && !time.Now().Before(deadline) {
+				return utxos, lastAddr, lastUTXOID, true, nil // Ran out of time; stop.
 			}
 		}
 	}
-	return utxos, lastAddr, lastUTXOID, nil // Didn't reach the [limit] utxos; no more were found
+	return utxos, lastAddr, lastUTXOID, false, nil // Didn't reach the [limit] utxos; no more were found
 }
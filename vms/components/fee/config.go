@@ -5,6 +5,15 @@
 // https://github.com/avalanche-foundation/ACPs/tree/main/ACPs/103-dynamic-fees
 package fee
 
+import "errors"
+
+var (
+	ErrNoWeights                    = errors.New("all weights are zero")
+	ErrZeroTargetGasPerSecond       = errors.New("target gas per second must be positive")
+	ErrTargetGasPerSecondAboveMax   = errors.New("target gas per second can't be greater than max gas per second")
+	ErrZeroExcessConversionConstant = errors.New("excess conversion constant must be positive")
+)
+
 type Config struct {
 	// Weights to merge fee dimensions into a single gas value.
 	Weights Dimensions `json:"weights"`
@@ -20,3 +29,30 @@ type Config struct {
 	// Constant used to convert excess gas to a gas price.
 	ExcessConversionConstant Gas `json:"excessConversionConstant"`
 }
+
+// GasPrice returns the gas price implied by [excess], which is
+// MinGasPrice * e^(excess / ExcessConversionConstant).
+//
+// The returned price is never less than [floor]. This protects against a
+// change of MinGasPrice immediately lowering the gas price below [floor]. A
+// floor of 0 leaves the computed price unchanged.
+func (c Config) GasPrice(excess Gas, floor GasPrice) GasPrice {
+	return max(c.MinGasPrice.MulExp(excess, c.ExcessConversionConstant), floor)
+}
+
+// Verify returns an error if the config can't be used to price gas.
+func (c Config) Verify() error {
+	switch {
+	case c.Weights == Dimensions{}:
+		return ErrNoWeights
+	case c.TargetGasPerSecond == 0:
+		return ErrZeroTargetGasPerSecond
+	case c.TargetGasPerSecond > c.MaxGasPerSecond:
+		return ErrTargetGasPerSecondAboveMax
+	case c.ExcessConversionConstant == 0:
+		// MulExp divides by the excess conversion constant.
+		return ErrZeroExcessConversionConstant
+	default:
+		return nil
+	}
+}
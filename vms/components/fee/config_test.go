@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigGasPrice(t *testing.T) {
+	config := Config{
+		MinGasPrice:              10,
+		ExcessConversionConstant: 1,
+	}
+	tests := []struct {
+		name     string
+		excess   Gas
+		floor    GasPrice
+		expected GasPrice
+	}{
+		{
+			name:     "no excess no floor",
+			excess:   0,
+			floor:    0,
+			expected: 10,
+		},
+		{
+			name:     "excess no floor",
+			excess:   1,
+			floor:    0,
+			expected: 26, // ~ 10 * e
+		},
+		{
+			name:     "floor above computed price",
+			excess:   0,
+			floor:    15,
+			expected: 15,
+		},
+		{
+			name:     "floor below computed price",
+			excess:   1,
+			floor:    15,
+			expected: 26,
+		},
+		{
+			name:     "floor equal to computed price",
+			excess:   0,
+			floor:    10,
+			expected: 10,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, config.GasPrice(test.excess, test.floor))
+		})
+	}
+}
+
+func TestDefaultTestDynamicFeesConfig(t *testing.T) {
+	require := require.New(t)
+
+	config := DefaultTestDynamicFeesConfig()
+	require.NoError(config.Verify())
+
+	state := State{
+		Capacity: config.MaxGasCapacity,
+	}
+
+	// Without any excess, the gas price is the minimum gas price.
+	require.Equal(config.MinGasPrice, config.GasPrice(state.Excess, 0))
+
+	// Consuming gas increases the excess, which raises the gas price.
+	state, err := state.ConsumeGas(config.MaxGasCapacity / 2)
+	require.NoError(err)
+	price := config.GasPrice(state.Excess, 0)
+	require.Greater(price, config.MinGasPrice)
+
+	// Consuming more gas raises the gas price further.
+	state, err = state.ConsumeGas(config.MaxGasCapacity / 2)
+	require.NoError(err)
+	require.Greater(config.GasPrice(state.Excess, 0), price)
+
+	// Without any load, the excess decays and the gas price returns to the
+	// minimum gas price.
+	state = state.AdvanceTime(
+		config.MaxGasCapacity,
+		config.MaxGasPerSecond,
+		config.TargetGasPerSecond,
+		uint64(config.MaxGasCapacity/config.TargetGasPerSecond),
+	)
+	require.Equal(State{Capacity: config.MaxGasCapacity}, state)
+	require.Equal(config.MinGasPrice, config.GasPrice(state.Excess, 0))
+}
+
+func TestConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      func(*Config)
+		expectedErr error
+	}{
+		{
+			name:        "valid",
+			config:      func(*Config) {},
+			expectedErr: nil,
+		},
+		{
+			name: "no weights",
+			config: func(c *Config) {
+				c.Weights = Dimensions{}
+			},
+			expectedErr: ErrNoWeights,
+		},
+		{
+			name: "single weight",
+			config: func(c *Config) {
+				c.Weights = Dimensions{Compute: 1}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "zero target gas per second",
+			config: func(c *Config) {
+				c.TargetGasPerSecond = 0
+			},
+			expectedErr: ErrZeroTargetGasPerSecond,
+		},
+		{
+			name: "target gas per second above max",
+			config: func(c *Config) {
+				c.TargetGasPerSecond = c.MaxGasPerSecond + 1
+			},
+			expectedErr: ErrTargetGasPerSecondAboveMax,
+		},
+		{
+			name: "target gas per second equal to max",
+			config: func(c *Config) {
+				c.TargetGasPerSecond = c.MaxGasPerSecond
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "zero excess conversion constant",
+			config: func(c *Config) {
+				c.ExcessConversionConstant = 0
+			},
+			expectedErr: ErrZeroExcessConversionConstant,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := DefaultTestDynamicFeesConfig()
+			test.config(&config)
+			err := config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -25,6 +25,17 @@ func (g Gas) Cost(price GasPrice) (uint64, error) {
 	return safemath.Mul(uint64(g), uint64(price))
 }
 
+// CostWithTip converts the gas to nAVAX based on the price and adds [tip].
+//
+// If overflow would occur, an error is returned.
+func (g Gas) CostWithTip(price GasPrice, tip uint64) (uint64, error) {
+	cost, err := g.Cost(price)
+	if err != nil {
+		return 0, err
+	}
+	return safemath.Add(cost, tip)
+}
+
 // AddPerSecond returns g + gasPerSecond * seconds.
 //
 // If overflow would occur, MaxUint64 is returned.
@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
 )
 
 var gasPriceMulExpTests = []struct {
@@ -92,6 +94,62 @@ func Test_Gas_Cost(t *testing.T) {
 	require.Equal(expected, actual)
 }
 
+func Test_Gas_CostWithTip(t *testing.T) {
+	tests := []struct {
+		name        string
+		gas         Gas
+		price       GasPrice
+		tip         uint64
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name:     "no tip",
+			gas:      40,
+			price:    100,
+			tip:      0,
+			expected: 4000,
+		},
+		{
+			name:     "tip",
+			gas:      40,
+			price:    100,
+			tip:      5,
+			expected: 4005,
+		},
+		{
+			name:     "tip without gas",
+			gas:      0,
+			price:    100,
+			tip:      5,
+			expected: 5,
+		},
+		{
+			name:        "cost overflow",
+			gas:         math.MaxUint64,
+			price:       2,
+			tip:         0,
+			expectedErr: safemath.ErrOverflow,
+		},
+		{
+			name:        "tip overflow",
+			gas:         1,
+			price:       1,
+			tip:         math.MaxUint64,
+			expectedErr: safemath.ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.gas.CostWithTip(test.price, test.tip)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
 func Test_Gas_AddPerSecond(t *testing.T) {
 	tests := []struct {
 		initial      Gas
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import "sync"
+
+// History records the gas prices of the most recently accepted blocks.
+//
+// History is safe for concurrent use.
+type History struct {
+	lock sync.RWMutex
+	// prices is used as a ring buffer. The newest price is at index
+	// [next]-1, wrapping around to the end of [prices].
+	prices []GasPrice
+	next   int
+	len    int
+}
+
+// NewHistory returns a History that retains up to [size] gas prices.
+func NewHistory(size int) *History {
+	return &History{
+		prices: make([]GasPrice, max(size, 0)),
+	}
+}
+
+// Record adds [price] as the newest gas price, evicting the oldest gas price
+// if the history is full.
+func (h *History) Record(price GasPrice) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.prices) == 0 {
+		return
+	}
+
+	h.prices[h.next] = price
+	h.next = (h.next + 1) % len(h.prices)
+	h.len = min(h.len+1, len(h.prices))
+}
+
+// FeeHistory returns up to [n] of the most recently recorded gas prices,
+// ordered from newest to oldest.
+func (h *History) FeeHistory(n int) []GasPrice {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	n = min(max(n, 0), h.len)
+	prices := make([]GasPrice, n)
+	for i := range prices {
+		index := (h.next - 1 - i + len(h.prices)) % len(h.prices)
+		prices[i] = h.prices[index]
+	}
+	return prices
+}
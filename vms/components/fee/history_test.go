@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		prices   []GasPrice
+		n        int
+		expected []GasPrice
+	}{
+		{
+			name:     "empty",
+			size:     3,
+			n:        3,
+			expected: []GasPrice{},
+		},
+		{
+			name:     "partially full",
+			size:     3,
+			prices:   []GasPrice{1, 2},
+			n:        3,
+			expected: []GasPrice{2, 1},
+		},
+		{
+			name:     "full",
+			size:     3,
+			prices:   []GasPrice{1, 2, 3},
+			n:        3,
+			expected: []GasPrice{3, 2, 1},
+		},
+		{
+			name:     "wrapped",
+			size:     3,
+			prices:   []GasPrice{1, 2, 3, 4, 5},
+			n:        3,
+			expected: []GasPrice{5, 4, 3},
+		},
+		{
+			name:     "fewer than retained",
+			size:     3,
+			prices:   []GasPrice{1, 2, 3, 4, 5},
+			n:        2,
+			expected: []GasPrice{5, 4},
+		},
+		{
+			name:     "more than retained",
+			size:     3,
+			prices:   []GasPrice{1, 2, 3, 4},
+			n:        10,
+			expected: []GasPrice{4, 3, 2},
+		},
+		{
+			name:     "negative n",
+			size:     3,
+			prices:   []GasPrice{1, 2},
+			n:        -1,
+			expected: []GasPrice{},
+		},
+		{
+			name:     "zero size",
+			size:     0,
+			prices:   []GasPrice{1, 2},
+			n:        1,
+			expected: []GasPrice{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := NewHistory(test.size)
+			for _, price := range test.prices {
+				h.Record(price)
+			}
+			require.Equal(t, test.expected, h.FeeHistory(test.n))
+		})
+	}
+}
@@ -61,3 +61,13 @@ func (s State) ConsumeGas(gas Gas) (State, error) {
 		Excess:   Gas(newExcess),
 	}, nil
 }
+
+// MarginalFee returns the cost of consuming an additional [gas] at [price].
+//
+// If the capacity is insufficient to consume [gas], an error is returned.
+func (s State) MarginalFee(gas Gas, price GasPrice) (uint64, error) {
+	if gas > s.Capacity {
+		return 0, ErrInsufficientCapacity
+	}
+	return gas.Cost(price)
+}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
 )
 
 func Test_State_AdvanceTime(t *testing.T) {
@@ -157,3 +159,67 @@ func Test_State_ConsumeGas(t *testing.T) {
 		})
 	}
 }
+
+func Test_State_MarginalFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     State
+		gas         Gas
+		price       GasPrice
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name: "fits in capacity",
+			initial: State{
+				Capacity: 10,
+				Excess:   10,
+			},
+			gas:         5,
+			price:       3,
+			expected:    15,
+			expectedErr: nil,
+		},
+		{
+			name: "exactly fills capacity",
+			initial: State{
+				Capacity: 10,
+				Excess:   10,
+			},
+			gas:         10,
+			price:       3,
+			expected:    30,
+			expectedErr: nil,
+		},
+		{
+			name: "exceeds capacity",
+			initial: State{
+				Capacity: 10,
+				Excess:   10,
+			},
+			gas:         11,
+			price:       3,
+			expected:    0,
+			expectedErr: ErrInsufficientCapacity,
+		},
+		{
+			name: "overflow",
+			initial: State{
+				Capacity: math.MaxUint64,
+			},
+			gas:         math.MaxUint64,
+			price:       2,
+			expected:    0,
+			expectedErr: safemath.ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.initial.MarginalFee(test.gas, test.price)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
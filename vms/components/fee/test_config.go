@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+// DefaultTestDynamicFeesConfig returns a minimal, valid Config for use in
+// tests.
+//
+// Every dimension is weighted equally, the target gas rate is half of the max
+// gas rate, and the gas price only rises meaningfully once the excess is a
+// sizable fraction of the capacity. With no excess, the gas price is exactly
+// MinGasPrice.
+func DefaultTestDynamicFeesConfig() Config {
+	return Config{
+		Weights: Dimensions{
+			Bandwidth: 1,
+			DBRead:    1,
+			DBWrite:   1,
+			Compute:   1,
+		},
+		MaxGasCapacity:           1_000_000,
+		MaxGasPerSecond:          1_000,
+		TargetGasPerSecond:       500,
+		MinGasPrice:              100,
+		ExcessConversionConstant: 100_000,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -98,6 +99,8 @@ func NewIndexer(
 // |  | "1"   => txID1
 // See interface documentation AddressTxsIndexer.Accept
 func (i *indexer) Accept(txID ids.ID, inputUTXOs []*avax.UTXO, outputUTXOs []*avax.UTXO) error {
+	startTime := time.Now()
+
 	utxos := inputUTXOs
 	// Fetch and add the output UTXOs
 	utxos = append(utxos, outputUTXOs...)
@@ -129,6 +132,7 @@ func (i *indexer) Accept(txID ids.ID, inputUTXOs []*avax.UTXO, outputUTXOs []*av
 	}
 
 	// Process the balance changes
+	var numAddressTxs int
 	for address, assetIDs := range balanceChanges {
 		addressPrefixDB := prefixdb.New([]byte(address), i.db)
 		for assetID := range assetIDs {
@@ -166,9 +170,13 @@ func (i *indexer) Accept(txID ids.ID, inputUTXOs []*avax.UTXO, outputUTXOs []*av
 			if err := assetPrefixDB.Put(idxKey, idxBytes); err != nil {
 				return fmt.Errorf("failed to write index txID while indexing %s: %w", txID, err)
 			}
+			numAddressTxs++
 		}
 	}
 	i.metrics.numTxsIndexed.Inc()
+	i.metrics.numAddressTxsIndexed.Add(float64(numAddressTxs))
+	i.metrics.addressTxsPerTx.Observe(float64(numAddressTxs))
+	i.metrics.indexingTime.Add(float64(time.Since(startTime)))
 	return nil
 }
 
@@ -3,10 +3,17 @@
 
 package index
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 type metrics struct {
-	numTxsIndexed prometheus.Counter
+	numTxsIndexed        prometheus.Counter
+	numAddressTxsIndexed prometheus.Counter
+	addressTxsPerTx      prometheus.Histogram
+	indexingTime         prometheus.Counter
 }
 
 func (m *metrics) initialize(namespace string, registerer prometheus.Registerer) error {
@@ -15,5 +22,26 @@ func (m *metrics) initialize(namespace string, registerer prometheus.Registerer)
 		Name:      "txs_indexed",
 		Help:      "Number of transactions indexed",
 	})
-	return registerer.Register(m.numTxsIndexed)
+	m.numAddressTxsIndexed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "address_txs_indexed",
+		Help:      "Number of (address, asset, transaction) references written to the index",
+	})
+	m.addressTxsPerTx = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "address_txs_per_tx",
+		Help:      "Number of (address, asset) references written to the index per transaction",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	})
+	m.indexingTime = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "indexing_time",
+		Help:      "Time (in ns) spent indexing transactions",
+	})
+	return errors.Join(
+		registerer.Register(m.numTxsIndexed),
+		registerer.Register(m.numAddressTxsIndexed),
+		registerer.Register(m.addressTxsPerTx),
+		registerer.Register(m.indexingTime),
+	)
 }
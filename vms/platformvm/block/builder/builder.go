@@ -338,6 +338,10 @@ func packBlockTxs(
 		feeCalculator = state.PickFeeCalculator(backend.Config, stateDiff)
 	)
 	for {
+		if maxTxs := backend.Config.MaxTxsPerBlock; maxTxs > 0 && len(blockTxs) >= maxTxs {
+			break
+		}
+
 		tx, exists := mempool.Peek()
 		if !exists {
 			break
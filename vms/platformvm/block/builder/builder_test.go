@@ -74,6 +74,49 @@ func TestBuildBlockBasic(t *testing.T) {
 	require.NoError(env.mempool.GetDropReason(txID))
 }
 
+func TestBuildBlockMaxTxsPerBlock(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, latestFork)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	env.config.MaxTxsPerBlock = 1
+
+	// Create non-conflicting transactions, each funded by a different key
+	txIDs := make([]ids.ID, 2)
+	for i, key := range preFundedKeys[3:5] {
+		builder, signer := env.factory.NewWallet(key)
+		utx, err := builder.NewCreateSubnetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.PublicKey().Address()},
+			},
+		)
+		require.NoError(err)
+		tx, err := walletsigner.SignUnsigned(context.Background(), signer, utx)
+		require.NoError(err)
+		txIDs[i] = tx.ID()
+
+		env.ctx.Lock.Unlock()
+		require.NoError(env.network.IssueTxFromRPC(tx))
+		env.ctx.Lock.Lock()
+	}
+
+	// [BuildBlock] should only pack [MaxTxsPerBlock] transactions
+	blkIntf, err := env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+
+	require.IsType(&blockexecutor.Block{}, blkIntf)
+	blk := blkIntf.(*blockexecutor.Block)
+	require.Len(blk.Txs(), 1)
+	require.Equal(txIDs[0], blk.Txs()[0].ID())
+
+	// The remaining transaction should be left in the mempool
+	_, ok := env.mempool.Get(txIDs[1])
+	require.True(ok)
+}
+
 func TestBuildBlockDoesNotBuildWithEmptyMempool(t *testing.T) {
 	require := require.New(t)
 
@@ -6,14 +6,21 @@ package executor
 import (
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/CaiJiJi/avalanchego/utils"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/config"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/metrics"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/validators"
+
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
+	txfee "github.com/CaiJiJi/avalanchego/vms/platformvm/txs/fee"
 )
 
 var (
@@ -30,6 +37,8 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+	config       *config.Config
+	feeHistory   *fee.History
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -104,6 +113,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.recordGasPrice()
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -173,6 +184,13 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	// The txs of an option block are included in its parent.
+	if a.nearGasCapacity(parentState.statelessBlock, blkState.timestamp) {
+		a.metrics.MarkNearGasCapacity()
+	}
+
+	a.recordGasPrice()
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -252,6 +270,12 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	if a.nearGasCapacity(b, blkState.timestamp) {
+		a.metrics.MarkNearGasCapacity()
+	}
+
+	a.recordGasPrice()
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -278,3 +302,60 @@ func (a *acceptor) commonAccept(b block.Block) error {
 	a.validators.OnAcceptedBlockID(blkID)
 	return nil
 }
+
+// recordGasPrice records the gas price of the last accepted state in the fee
+// history.
+func (a *acceptor) recordGasPrice() {
+	feeState := a.state.GetFeeState()
+	a.feeHistory.Record(a.config.DynamicFeeConfig.GasPrice(
+		feeState.Excess,
+		a.config.GasPriceFloor,
+	))
+}
+
+// nearGasCapacity returns true, and logs a warning, if [b] was accepted with
+// [timestamp] after the E-upgrade and the gas consumed by its txs exceeds
+// Config.NearGasCapacityThreshold of the maximum gas capacity. Sustained
+// blocks near capacity cause subsequent txs to be rejected.
+//
+// The gas is calculated from the txs of [b], so the fee state isn't modified.
+func (a *acceptor) nearGasCapacity(b block.Block, timestamp time.Time) bool {
+	if a.config.NearGasCapacityThreshold <= 0 || !a.config.UpgradeConfig.IsEtnaActivated(timestamp) {
+		return false
+	}
+
+	var blockGas fee.Gas
+	for _, tx := range b.Txs() {
+		gas, err := txfee.ExpectedGas(tx, a.config.DynamicFeeConfig)
+		if errors.Is(err, txfee.ErrUnsupportedTx) {
+			// Txs that aren't charged for their complexity don't consume gas.
+			continue
+		}
+		if err != nil {
+			a.ctx.Log.Debug("couldn't calculate tx gas",
+				zap.Stringer("txID", tx.ID()),
+				zap.Error(err),
+			)
+			return false
+		}
+		blockGas, err = safemath.Add(blockGas, gas)
+		if err != nil {
+			blockGas = math.MaxUint64
+			break
+		}
+	}
+
+	threshold := a.config.NearGasCapacityThreshold * float64(a.config.DynamicFeeConfig.MaxGasCapacity)
+	if float64(blockGas) <= threshold {
+		return false
+	}
+
+	a.ctx.Log.Warn("block consumed gas near capacity",
+		zap.Stringer("blkID", b.ID()),
+		zap.Uint64("height", b.Height()),
+		zap.Uint64("blockGas", uint64(blockGas)),
+		zap.Uint64("maxGasCapacity", uint64(a.config.DynamicFeeConfig.MaxGasCapacity)),
+		zap.Float64("threshold", a.config.NearGasCapacityThreshold),
+	)
+	return true
+}
@@ -14,16 +14,21 @@ import (
 	"github.com/CaiJiJi/avalanchego/database"
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/snow"
+	"github.com/CaiJiJi/avalanchego/upgrade"
 	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/utils/logging"
 	"github.com/CaiJiJi/avalanchego/utils/timer/mockable"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/components/verify"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/config"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/metrics"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/validators"
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
+
+	txfee "github.com/CaiJiJi/avalanchego/vms/platformvm/txs/fee"
 )
 
 func TestAcceptorVisitProposalBlock(t *testing.T) {
@@ -97,6 +102,10 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 		},
 		metrics:    metrics.Noop,
 		validators: validators.TestManager,
+		config: &config.Config{
+			DynamicFeeConfig: fee.DefaultTestDynamicFeesConfig(),
+		},
+		feeHistory: fee.NewHistory(1),
 	}
 
 	blk, err := block.NewApricotAtomicBlock(
@@ -151,9 +160,12 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
 	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+	s.EXPECT().GetFeeState().Return(fee.State{}).Times(1)
 	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
 
 	require.NoError(acceptor.ApricotAtomicBlock(blk))
+	// Without any excess, the recorded gas price is the minimum gas price.
+	require.Equal([]fee.GasPrice{acceptor.config.DynamicFeeConfig.MinGasPrice}, acceptor.feeHistory.FeeHistory(1))
 }
 
 func TestAcceptorVisitStandardBlock(t *testing.T) {
@@ -177,6 +189,10 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 		},
 		metrics:    metrics.Noop,
 		validators: validators.TestManager,
+		config: &config.Config{
+			DynamicFeeConfig: fee.DefaultTestDynamicFeesConfig(),
+		},
+		feeHistory: fee.NewHistory(1),
 	}
 
 	blk, err := block.NewBanffStandardBlock(
@@ -239,11 +255,14 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
 	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
+	s.EXPECT().GetFeeState().Return(fee.State{}).Times(1)
 	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
 
 	require.NoError(acceptor.BanffStandardBlock(blk))
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
+	// Without any excess, the recorded gas price is the minimum gas price.
+	require.Equal([]fee.GasPrice{acceptor.config.DynamicFeeConfig.MinGasPrice}, acceptor.feeHistory.FeeHistory(1))
 }
 
 func TestAcceptorVisitCommitBlock(t *testing.T) {
@@ -267,6 +286,10 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 		metrics:      metrics.Noop,
 		validators:   validators.TestManager,
 		bootstrapped: &utils.Atomic[bool]{},
+		config: &config.Config{
+			GasPriceFloor: 3,
+		},
+		feeHistory: fee.NewHistory(1),
 	}
 
 	blk, err := block.NewApricotCommitBlock(parentID, 1 /*height*/)
@@ -347,6 +370,7 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 		parentOnCommitState.EXPECT().Apply(s).Times(1),
 		s.EXPECT().CommitBatch().Return(batch, nil).Times(1),
 		sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1),
+		s.EXPECT().GetFeeState().Return(fee.State{}).Times(1),
 		s.EXPECT().Checksum().Return(ids.Empty).Times(1),
 		s.EXPECT().Abort().Times(1),
 	)
@@ -354,6 +378,8 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 	require.NoError(acceptor.ApricotCommitBlock(blk))
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
+	// The gas price is never recorded below the floor.
+	require.Equal([]fee.GasPrice{3}, acceptor.feeHistory.FeeHistory(1))
 }
 
 func TestAcceptorVisitAbortBlock(t *testing.T) {
@@ -377,6 +403,8 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 		metrics:      metrics.Noop,
 		validators:   validators.TestManager,
 		bootstrapped: &utils.Atomic[bool]{},
+		config:       &config.Config{},
+		feeHistory:   fee.NewHistory(1),
 	}
 
 	blk, err := block.NewApricotAbortBlock(parentID, 1 /*height*/)
@@ -457,6 +485,7 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 		parentOnAbortState.EXPECT().Apply(s).Times(1),
 		s.EXPECT().CommitBatch().Return(batch, nil).Times(1),
 		sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1),
+		s.EXPECT().GetFeeState().Return(fee.State{}).Times(1),
 		s.EXPECT().Checksum().Return(ids.Empty).Times(1),
 		s.EXPECT().Abort().Times(1),
 	)
@@ -465,3 +494,80 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
+
+func TestAcceptorNearGasCapacity(t *testing.T) {
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{},
+	}
+	dynamicFeeConfig := fee.DefaultTestDynamicFeesConfig()
+	txGas, err := txfee.ExpectedGas(tx, dynamicFeeConfig)
+	require.NoError(t, err)
+	require.NotZero(t, txGas)
+
+	tests := []struct {
+		name           string
+		etnaTime       time.Time
+		threshold      float64
+		maxGasCapacity fee.Gas
+		expected       bool
+	}{
+		{
+			name:           "above threshold",
+			threshold:      .9,
+			maxGasCapacity: txGas,
+			expected:       true,
+		},
+		{
+			name:           "below threshold",
+			threshold:      .9,
+			maxGasCapacity: 2 * txGas,
+			expected:       false,
+		},
+		{
+			name:           "disabled",
+			threshold:      0,
+			maxGasCapacity: txGas,
+			expected:       false,
+		},
+		{
+			name:           "before etna",
+			etnaTime:       mockable.MaxTime,
+			threshold:      .9,
+			maxGasCapacity: txGas,
+			expected:       false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			feeConfig := dynamicFeeConfig
+			feeConfig.MaxGasCapacity = test.maxGasCapacity
+
+			timestamp := time.Now()
+			blk, err := block.NewBanffStandardBlock(
+				timestamp,
+				ids.GenerateTestID(),
+				2,
+				[]*txs.Tx{tx},
+			)
+			require.NoError(err)
+
+			acceptor := &acceptor{
+				backend: &backend{
+					ctx: &snow.Context{
+						Log: logging.NoLog{},
+					},
+				},
+				config: &config.Config{
+					UpgradeConfig: upgrade.Config{
+						EtnaTime: test.etnaTime,
+					},
+					DynamicFeeConfig:         feeConfig,
+					NearGasCapacityThreshold: test.threshold,
+				},
+			}
+			require.Equal(test.expected, acceptor.nearGasCapacity(blk, timestamp))
+		})
+	}
+}
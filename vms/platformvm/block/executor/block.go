@@ -48,11 +48,15 @@ func (b *Block) VerifyWithContext(_ context.Context, ctx *smblock.Context) error
 		return nil
 	}
 
-	return b.Visit(&verifier{
+	err := b.Visit(&verifier{
 		backend:           b.manager.backend,
 		txExecutorBackend: b.manager.txExecutorBackend,
 		pChainHeight:      pChainHeight,
 	})
+	if err != nil {
+		b.manager.metrics.MarkVerificationFailed(verificationFailureReason(err))
+	}
+	return err
 }
 
 func (b *Block) Verify(ctx context.Context) error {
@@ -38,6 +38,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/timer/mockable"
 	"github.com/CaiJiJi/avalanchego/utils/units"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/api"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/config"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/fx"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/metrics"
@@ -523,20 +524,106 @@ func addPendingValidator(
 		return nil, err
 	}
 
-	staker, err := state.NewPendingStaker(
-		addPendingValidatorTx.ID(),
-		addPendingValidatorTx.Unsigned.(*txs.AddValidatorTx),
-	)
-	if err != nil {
+	if err := addPendingValidators(env, []*txs.Tx{addPendingValidatorTx}); err != nil {
 		return nil, err
 	}
+	return addPendingValidatorTx, nil
+}
 
-	env.state.PutPendingValidator(staker)
-	env.state.AddTx(addPendingValidatorTx, status.Committed)
+// addPendingValidators adds the validators of all of the [validatorTxs] to the
+// pending staker set and commits them to the state at once.
+func addPendingValidators(env *environment, validatorTxs []*txs.Tx) error {
+	stakers := make([]*state.Staker, len(validatorTxs))
+	for i, tx := range validatorTxs {
+		staker, err := state.NewPendingStaker(
+			tx.ID(),
+			tx.Unsigned.(txs.ScheduledStaker),
+		)
+		if err != nil {
+			return err
+		}
+		stakers[i] = staker
+
+		env.state.AddTx(tx, status.Committed)
+	}
+
+	state.PutPendingValidators(env.state, stakers)
 	dummyHeight := uint64(1)
 	env.state.SetHeight(dummyHeight)
-	if err := env.state.Commit(); err != nil {
-		return nil, err
+	return env.state.Commit()
+}
+
+// advanceTimeTo builds, verifies, and accepts the standard blocks needed to move
+// the chain time to [newTime]. A block is issued at every staker change time up
+// to [newTime] so that no staker set change is skipped.
+//
+// Empty Banff standard blocks are only valid if they change the staker set, so
+// [newTime] must be a staker change time. Advancing to any other time, or past a
+// staker that must first be removed with a reward tx, fails the test.
+func advanceTimeTo(t *testing.T, env *environment, newTime time.Time) {
+	require := require.New(t)
+
+	if env.clk.Time().Before(newTime) {
+		env.clk.Set(newTime)
 	}
-	return addPendingValidatorTx, nil
+
+	for chainTime := env.state.GetTimestamp(); chainTime.Before(newTime); chainTime = env.state.GetTimestamp() {
+		nextStakerChangeTime, err := state.GetNextStakerChangeTime(env.state)
+		require.NoError(err)
+		require.True(
+			chainTime.Before(nextStakerChangeTime),
+			"staker must be removed at %s before advancing to %s",
+			nextStakerChangeTime,
+			newTime,
+		)
+		require.False(
+			nextStakerChangeTime.After(newTime),
+			"advancing to %s performs no staker changes before %s",
+			newTime,
+			nextStakerChangeTime,
+		)
+
+		parentBlk, err := env.state.GetStatelessBlock(env.state.GetLastAccepted())
+		require.NoError(err)
+		statelessStandardBlock, err := block.NewBanffStandardBlock(
+			nextStakerChangeTime,
+			parentBlk.ID(),
+			parentBlk.Height()+1,
+			nil, // txs nulled to simplify test
+		)
+		require.NoError(err)
+
+		blk := env.blkManager.NewBlock(statelessStandardBlock)
+		require.NoError(blk.Verify(context.Background()))
+		require.NoError(blk.Accept(context.Background()))
+	}
+}
+
+// newBanffProposalBlock creates a Banff proposal block that proposes [tx] on
+// top of [parentID]. The block timestamp is the time that would be chosen by
+// the block builder for a child of [parentID].
+func newBanffProposalBlock(
+	t *testing.T,
+	env *environment,
+	parentID ids.ID,
+	height uint64,
+	tx *txs.Tx,
+) *block.BanffProposalBlock {
+	require := require.New(t)
+
+	parentState, ok := env.blkManager.(*manager).GetState(parentID)
+	require.True(ok)
+
+	timestamp, _, err := state.NextBlockTime(parentState, env.clk)
+	require.NoError(err)
+
+	blk, err := block.NewBanffProposalBlock(
+		timestamp,
+		parentID,
+		height,
+		tx,
+		[]*txs.Tx{},
+	)
+	require.NoError(err)
+	return blk
 }
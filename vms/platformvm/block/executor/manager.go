@@ -5,10 +5,13 @@ package executor
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/snow/consensus/snowman"
 	"github.com/CaiJiJi/avalanchego/utils/set"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/metrics"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
@@ -16,12 +19,20 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs/executor"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs/mempool"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/validators"
+
+	txfee "github.com/CaiJiJi/avalanchego/vms/platformvm/txs/fee"
 )
 
+// feeHistorySize is the number of accepted blocks whose gas prices are
+// retained by the manager.
+const feeHistorySize = 128
+
 var (
 	_ Manager = (*manager)(nil)
 
-	ErrChainNotSynced = errors.New("chain not synced")
+	ErrChainNotSynced     = errors.New("chain not synced")
+	ErrBlockNotVerified   = errors.New("block not verified")
+	ErrGasPriceBelowFloor = errors.New("gas price below floor")
 )
 
 type Manager interface {
@@ -44,6 +55,27 @@ type Manager interface {
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
+
+	// VerifyAgainst verifies [blk] as if [parentState] were the state of its
+	// parent, rather than looking the parent's state up. If [blk] is an option
+	// block, [parentState] is used as the state of the parent's option.
+	//
+	// The parent of [blk] must still be known. The state resulting from the
+	// verification is discarded, so this can be used to re-verify historical
+	// blocks.
+	VerifyAgainst(blk block.Block, parentState state.Chain) error
+
+	// AtomicInputs returns the IDs of the atomic inputs consumed by the
+	// verified block [blkID].
+	AtomicInputs(blkID ids.ID) (set.Set[ids.ID], error)
+
+	// BlockTimestamp returns the chain time after the verified block [blkID]
+	// is accepted.
+	BlockTimestamp(blkID ids.ID) (time.Time, error)
+
+	// FeeHistory returns the gas prices of up to the [n] most recently
+	// accepted blocks, ordered from newest to oldest.
+	FeeHistory(n int) []fee.GasPrice
 }
 
 func NewManager(
@@ -62,6 +94,7 @@ func NewManager(
 		blkIDToState: map[ids.ID]*blockState{},
 	}
 
+	feeHistory := fee.NewHistory(feeHistorySize)
 	return &manager{
 		backend: backend,
 		acceptor: &acceptor{
@@ -69,13 +102,17 @@ func NewManager(
 			metrics:      metrics,
 			validators:   validatorManager,
 			bootstrapped: txExecutorBackend.Bootstrapped,
+			config:       txExecutorBackend.Config,
+			feeHistory:   feeHistory,
 		},
 		rejector: &rejector{
 			backend:         backend,
 			addTxsToMempool: !txExecutorBackend.Config.PartialSyncPrimaryNetwork,
 		},
+		metrics:           metrics,
 		preferred:         lastAccepted,
 		txExecutorBackend: txExecutorBackend,
+		feeHistory:        feeHistory,
 	}
 }
 
@@ -83,9 +120,11 @@ type manager struct {
 	*backend
 	acceptor block.Visitor
 	rejector block.Visitor
+	metrics  metrics.Metrics
 
 	preferred         ids.ID
 	txExecutorBackend *executor.Backend
+	feeHistory        *fee.History
 }
 
 func (m *manager) GetBlock(blkID ids.ID) (snowman.Block, error) {
@@ -138,14 +177,135 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 	}
 
 	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
-	return tx.Unsigned.Visit(&executor.StandardTxExecutor{
+	err = tx.Unsigned.Visit(&executor.StandardTxExecutor{
 		Backend:       m.txExecutorBackend,
 		State:         stateDiff,
 		FeeCalculator: feeCalculator,
 		Tx:            tx,
 	})
+	if err != nil {
+		return err
+	}
+	return m.verifyGasPrice(tx, feeCalculator, stateDiff)
+}
+
+// verifyGasPrice returns an error if, after the E-upgrade, [tx] pays less per
+// unit of gas than the gas price implied by [chainState], floored at
+// Config.GasPriceFloor. Txs aren't checked if the floor is 0.
+func (m *manager) verifyGasPrice(tx *txs.Tx, feeCalculator txfee.Calculator, chainState state.Chain) error {
+	cfg := m.txExecutorBackend.Config
+	if cfg.GasPriceFloor == 0 || !cfg.UpgradeConfig.IsEtnaActivated(chainState.GetTimestamp()) {
+		return nil
+	}
+
+	gas, err := txfee.ExpectedGas(tx, cfg.DynamicFeeConfig)
+	if errors.Is(err, txfee.ErrUnsupportedTx) {
+		// Txs that aren't charged for their complexity don't pay for gas.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	gasPrice := cfg.DynamicFeeConfig.GasPrice(chainState.GetFeeState().Excess, cfg.GasPriceFloor)
+	minFee, err := gas.Cost(gasPrice)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrGasPriceBelowFloor, err)
+	}
+	txFee, err := feeCalculator.CalculateFee(tx.Unsigned)
+	if err != nil {
+		return err
+	}
+	if txFee < minFee {
+		return fmt.Errorf("%w: fee %d < %d for %d gas at price %d",
+			ErrGasPriceBelowFloor,
+			txFee,
+			minFee,
+			gas,
+			gasPrice,
+		)
+	}
+	return nil
 }
 
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
 	return m.backend.verifyUniqueInputs(blkID, inputs)
 }
+
+func (m *manager) AtomicInputs(blkID ids.ID) (set.Set[ids.ID], error) {
+	blkState, ok := m.blkIDToState[blkID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBlockNotVerified, blkID)
+	}
+
+	inputs := set.NewSet[ids.ID](blkState.inputs.Len())
+	inputs.Union(blkState.inputs)
+	return inputs, nil
+}
+
+func (m *manager) BlockTimestamp(blkID ids.ID) (time.Time, error) {
+	blkState, ok := m.blkIDToState[blkID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrBlockNotVerified, blkID)
+	}
+	return blkState.timestamp, nil
+}
+
+func (m *manager) FeeHistory(n int) []fee.GasPrice {
+	return m.feeHistory.FeeHistory(n)
+}
+
+func (m *manager) VerifyAgainst(blk block.Block, parentState state.Chain) error {
+	parentID := blk.Parent()
+	parent, err := m.backend.GetBlock(parentID)
+	if err != nil {
+		return err
+	}
+
+	parentBlkState := &blockState{
+		statelessBlock: parent,
+		timestamp:      parentState.GetTimestamp(),
+	}
+	if isProposalBlock(parent) {
+		parentBlkState.onCommitState, err = state.NewDiffOn(parentState)
+		if err != nil {
+			return err
+		}
+		parentBlkState.onAbortState, err = state.NewDiffOn(parentState)
+		if err != nil {
+			return err
+		}
+	} else {
+		parentBlkState.onAcceptState, err = state.NewDiffOn(parentState)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Verify [blk] with a backend that only knows about its parent, so that
+	// neither the processing blocks nor the results of the verification are
+	// shared with this manager.
+	return blk.Visit(&verifier{
+		backend: &backend{
+			Mempool:      readOnlyMempool{Mempool: m.Mempool},
+			lastAccepted: m.lastAccepted,
+			blkIDToState: map[ids.ID]*blockState{
+				parentID: parentBlkState,
+			},
+			state: m.state,
+			ctx:   m.ctx,
+		},
+		txExecutorBackend: m.txExecutorBackend,
+	})
+}
+
+// readOnlyMempool ignores the removal of transactions and the marking of
+// transactions as dropped, so that verifying a block doesn't modify the wrapped
+// mempool.
+type readOnlyMempool struct {
+	mempool.Mempool
+}
+
+func (readOnlyMempool) Remove(...*txs.Tx) {}
+
+func (readOnlyMempool) MarkDropped(ids.ID, error) {}
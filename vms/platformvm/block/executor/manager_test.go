@@ -4,15 +4,28 @@
 package executor
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/CaiJiJi/avalanchego/database"
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/upgrade"
+	"github.com/CaiJiJi/avalanchego/utils/constants"
+	"github.com/CaiJiJi/avalanchego/utils/crypto/secp256k1"
+	"github.com/CaiJiJi/avalanchego/utils/set"
+	"github.com/CaiJiJi/avalanchego/utils/timer/mockable"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/config"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs/executor"
+
+	txfee "github.com/CaiJiJi/avalanchego/vms/platformvm/txs/fee"
 )
 
 func TestGetBlock(t *testing.T) {
@@ -86,3 +99,235 @@ func TestManagerSetPreference(t *testing.T) {
 	require.False(manager.SetPreference(newPreference))
 	require.True(manager.SetPreference(initialPreference))
 }
+
+func TestManagerVerifyAgainst(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, nil, banff)
+
+	// Add a pending validator so that the block has state changes to verify.
+	pendingValidatorStartTime := defaultGenesisTime.Add(1 * time.Second)
+	pendingValidatorEndTime := pendingValidatorStartTime.Add(defaultMinStakingDuration)
+	nodeID := ids.GenerateTestNodeID()
+	_, err := addPendingValidator(
+		env,
+		pendingValidatorStartTime,
+		pendingValidatorEndTime,
+		nodeID,
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+	)
+	require.NoError(err)
+
+	parentID := env.state.GetLastAccepted()
+	parentBlk, err := env.state.GetStatelessBlock(parentID)
+	require.NoError(err)
+	statelessBlk, err := block.NewBanffStandardBlock(
+		pendingValidatorStartTime,
+		parentID,
+		parentBlk.Height()+1,
+		nil, // txs nulled to simplify test
+	)
+	require.NoError(err)
+
+	parentState, err := state.NewDiff(parentID, env.blkManager)
+	require.NoError(err)
+	require.NoError(env.blkManager.VerifyAgainst(statelessBlk, parentState))
+
+	// The result of the verification must not be tracked by the manager.
+	require.NotContains(env.blkManager.(*manager).blkIDToState, statelessBlk.ID())
+
+	// The supplied parent state must not have been modified.
+	_, err = parentState.GetPendingValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+
+	// The supplied parent state must be used rather than the accepted state.
+	parentState.SetTimestamp(pendingValidatorStartTime.Add(time.Second))
+	err = env.blkManager.VerifyAgainst(statelessBlk, parentState)
+	require.ErrorIs(err, errChildBlockEarlierThanParent)
+}
+
+func TestManagerVerifyAgainstDoesNotModifyMempool(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, nil, banff)
+
+	// The validator is already pending, so issuing its tx again must fail.
+	pendingValidatorStartTime := defaultGenesisTime.Add(1 * time.Second)
+	tx, err := addPendingValidator(
+		env,
+		pendingValidatorStartTime,
+		pendingValidatorStartTime.Add(defaultMinStakingDuration),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+	)
+	require.NoError(err)
+
+	parentID := env.state.GetLastAccepted()
+	parentBlk, err := env.state.GetStatelessBlock(parentID)
+	require.NoError(err)
+	statelessBlk, err := block.NewBanffStandardBlock(
+		defaultGenesisTime,
+		parentID,
+		parentBlk.Height()+1,
+		[]*txs.Tx{tx},
+	)
+	require.NoError(err)
+
+	parentState, err := state.NewDiff(parentID, env.blkManager)
+	require.NoError(err)
+	err = env.blkManager.VerifyAgainst(statelessBlk, parentState)
+	require.ErrorIs(err, executor.ErrAlreadyValidator)
+
+	// The failed tx must not have been marked as dropped in the mempool.
+	require.NoError(env.mempool.GetDropReason(tx.ID()))
+}
+
+func TestManagerAtomicInputs(t *testing.T) {
+	require := require.New(t)
+
+	blkID := ids.GenerateTestID()
+	inputs := set.Of(ids.GenerateTestID(), ids.GenerateTestID())
+	manager := &manager{
+		backend: &backend{
+			blkIDToState: map[ids.ID]*blockState{
+				blkID: {
+					inputs: inputs,
+				},
+			},
+		},
+	}
+
+	gotInputs, err := manager.AtomicInputs(blkID)
+	require.NoError(err)
+	require.Equal(inputs, gotInputs)
+
+	// Modifying the returned inputs must not modify the block's state.
+	gotInputs.Add(ids.GenerateTestID())
+	require.Equal(2, manager.blkIDToState[blkID].inputs.Len())
+
+	_, err = manager.AtomicInputs(ids.GenerateTestID())
+	require.ErrorIs(err, ErrBlockNotVerified)
+}
+
+func TestManagerBlockTimestamp(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, nil, banff)
+
+	// An empty Banff standard block must change the staker set, so the block
+	// advances time to the start of a pending validator.
+	blkTime := defaultGenesisTime.Add(time.Minute)
+	_, err := addPendingValidator(
+		env,
+		blkTime,
+		blkTime.Add(defaultMinStakingDuration),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+	)
+	require.NoError(err)
+
+	parentID := env.state.GetLastAccepted()
+	parentBlk, err := env.state.GetStatelessBlock(parentID)
+	require.NoError(err)
+
+	env.clk.Set(blkTime)
+	statelessBlk, err := block.NewBanffStandardBlock(
+		blkTime,
+		parentID,
+		parentBlk.Height()+1,
+		nil, // txs nulled to simplify test
+	)
+	require.NoError(err)
+
+	// The block's timestamp isn't known until it has been verified.
+	_, err = env.blkManager.BlockTimestamp(statelessBlk.ID())
+	require.ErrorIs(err, ErrBlockNotVerified)
+
+	blk := env.blkManager.NewBlock(statelessBlk)
+	require.NoError(blk.Verify(context.Background()))
+
+	timestamp, err := env.blkManager.BlockTimestamp(statelessBlk.ID())
+	require.NoError(err)
+	require.Equal(blkTime.Unix(), timestamp.Unix())
+}
+
+func TestManagerVerifyGasPrice(t *testing.T) {
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{},
+	}
+	// Without any excess, the dynamic gas price is MinGasPrice.
+	dynamicFeeConfig := fee.DefaultTestDynamicFeesConfig()
+	dynamicGasPrice := dynamicFeeConfig.MinGasPrice
+	txGas, err := txfee.ExpectedGas(tx, dynamicFeeConfig)
+	require.NoError(t, err)
+	require.NotZero(t, txGas)
+
+	tests := []struct {
+		name        string
+		etnaTime    time.Time
+		floor       fee.GasPrice
+		txFee       uint64
+		expectedErr error
+	}{
+		{
+			name:        "no floor",
+			floor:       0,
+			txFee:       0,
+			expectedErr: nil,
+		},
+		{
+			name:        "before etna",
+			etnaTime:    mockable.MaxTime,
+			floor:       2 * dynamicGasPrice,
+			txFee:       0,
+			expectedErr: nil,
+		},
+		{
+			name:        "pays floor",
+			floor:       2 * dynamicGasPrice,
+			txFee:       2 * uint64(dynamicGasPrice) * uint64(txGas),
+			expectedErr: nil,
+		},
+		{
+			name:        "pays less than floor",
+			floor:       2 * dynamicGasPrice,
+			txFee:       2*uint64(dynamicGasPrice)*uint64(txGas) - 1,
+			expectedErr: ErrGasPriceBelowFloor,
+		},
+		{
+			name:        "pays floor below dynamic gas price",
+			floor:       dynamicGasPrice / 2,
+			txFee:       uint64(dynamicGasPrice/2) * uint64(txGas),
+			expectedErr: ErrGasPriceBelowFloor,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			chainState := state.NewMockChain(ctrl)
+			chainState.EXPECT().GetTimestamp().Return(time.Time{}).AnyTimes()
+			chainState.EXPECT().GetFeeState().Return(fee.State{}).AnyTimes()
+
+			manager := &manager{
+				txExecutorBackend: &executor.Backend{
+					Config: &config.Config{
+						UpgradeConfig: upgrade.Config{
+							EtnaTime: test.etnaTime,
+						},
+						DynamicFeeConfig: dynamicFeeConfig,
+						GasPriceFloor:    test.floor,
+					},
+				},
+			}
+			feeCalculator := txfee.NewStaticCalculator(txfee.StaticConfig{
+				TxFee: test.txFee,
+			})
+			err := manager.verifyGasPrice(tx, feeCalculator, chainState)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -11,10 +11,12 @@ package executor
 
 import (
 	reflect "reflect"
+	time "time"
 
 	ids "github.com/CaiJiJi/avalanchego/ids"
 	snowman "github.com/CaiJiJi/avalanchego/snow/consensus/snowman"
 	set "github.com/CaiJiJi/avalanchego/utils/set"
+	fee "github.com/CaiJiJi/avalanchego/vms/components/fee"
 	block "github.com/CaiJiJi/avalanchego/vms/platformvm/block"
 	state "github.com/CaiJiJi/avalanchego/vms/platformvm/state"
 	txs "github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
@@ -44,6 +46,50 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// AtomicInputs mocks base method.
+func (m *MockManager) AtomicInputs(blkID ids.ID) (set.Set[ids.ID], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AtomicInputs", blkID)
+	ret0, _ := ret[0].(set.Set[ids.ID])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AtomicInputs indicates an expected call of AtomicInputs.
+func (mr *MockManagerMockRecorder) AtomicInputs(blkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AtomicInputs", reflect.TypeOf((*MockManager)(nil).AtomicInputs), blkID)
+}
+
+// BlockTimestamp mocks base method.
+func (m *MockManager) BlockTimestamp(blkID ids.ID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockTimestamp", blkID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTimestamp indicates an expected call of BlockTimestamp.
+func (mr *MockManagerMockRecorder) BlockTimestamp(blkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTimestamp", reflect.TypeOf((*MockManager)(nil).BlockTimestamp), blkID)
+}
+
+// FeeHistory mocks base method.
+func (m *MockManager) FeeHistory(n int) []fee.GasPrice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", n)
+	ret0, _ := ret[0].([]fee.GasPrice)
+	return ret0
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockManagerMockRecorder) FeeHistory(n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockManager)(nil).FeeHistory), n)
+}
+
 // GetBlock mocks base method.
 func (m *MockManager) GetBlock(blkID ids.ID) (snowman.Block, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +191,20 @@ func (mr *MockManagerMockRecorder) SetPreference(blkID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockManager)(nil).SetPreference), blkID)
 }
 
+// VerifyAgainst mocks base method.
+func (m *MockManager) VerifyAgainst(blk block.Block, parentState state.Chain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAgainst", blk, parentState)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyAgainst indicates an expected call of VerifyAgainst.
+func (mr *MockManagerMockRecorder) VerifyAgainst(blk, parentState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAgainst", reflect.TypeOf((*MockManager)(nil).VerifyAgainst), blk, parentState)
+}
+
 // VerifyTx mocks base method.
 func (m *MockManager) VerifyTx(tx *txs.Tx) error {
 	m.ctrl.T.Helper()
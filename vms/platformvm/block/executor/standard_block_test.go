@@ -341,6 +341,48 @@ func TestBanffStandardBlockUpdatePrimaryNetworkStakers(t *testing.T) {
 	require.True(ok)
 }
 
+func TestAdvanceTimeTo(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, nil, banff)
+
+	pendingValidatorStartTime := defaultGenesisTime.Add(time.Minute)
+	pendingValidatorEndTime := pendingValidatorStartTime.Add(defaultMinStakingDuration)
+	nodeID := ids.GenerateTestNodeID()
+	addPendingValidatorTx, err := addPendingValidator(
+		env,
+		pendingValidatorStartTime,
+		pendingValidatorEndTime,
+		nodeID,
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+	)
+	require.NoError(err)
+
+	lastAcceptedID := env.state.GetLastAccepted()
+	lastAcceptedBlk, err := env.state.GetStatelessBlock(lastAcceptedID)
+	require.NoError(err)
+
+	advanceTimeTo(t, env, pendingValidatorStartTime)
+	require.Equal(pendingValidatorStartTime.Unix(), env.state.GetTimestamp().Unix())
+
+	// A single block is needed to reach the validator's start time.
+	lastAcceptedID = env.state.GetLastAccepted()
+	newLastAcceptedBlk, err := env.state.GetStatelessBlock(lastAcceptedID)
+	require.NoError(err)
+	require.Equal(lastAcceptedBlk.Height()+1, newLastAcceptedBlk.Height())
+
+	currentValidator, err := env.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	require.Equal(addPendingValidatorTx.ID(), currentValidator.TxID)
+
+	_, err = env.state.GetPendingValidator(constants.PrimaryNetworkID, nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	_, ok := env.config.Validators.GetValidator(constants.PrimaryNetworkID, nodeID)
+	require.True(ok)
+}
+
 // Ensure semantic verification updates the current and pending staker sets correctly.
 // Namely, it should add pending stakers whose start time is at or before the timestamp.
 // It will not remove primary network stakers; that happens in rewardTxs.
@@ -538,25 +580,7 @@ func TestBanffStandardBlockUpdateStakers(t *testing.T) {
 			require.NoError(env.state.Commit())
 
 			for _, newTime := range test.advanceTimeTo {
-				env.clk.Set(newTime)
-
-				// build standard block moving ahead chain time
-				preferredID := env.state.GetLastAccepted()
-				parentBlk, err := env.state.GetStatelessBlock(preferredID)
-				require.NoError(err)
-				statelessStandardBlock, err := block.NewBanffStandardBlock(
-					newTime,
-					parentBlk.ID(),
-					parentBlk.Height()+1,
-					nil, // txs nulled to simplify test
-				)
-				block := env.blkManager.NewBlock(statelessStandardBlock)
-
-				require.NoError(err)
-
-				// update staker set
-				require.NoError(block.Verify(context.Background()))
-				require.NoError(block.Accept(context.Background()))
+				advanceTimeTo(t, env, newTime)
 			}
 
 			for stakerNodeID, status := range test.expectedStakers {
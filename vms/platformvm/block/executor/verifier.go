@@ -28,8 +28,38 @@ var (
 	errIncorrectBlockHeight                  = errors.New("incorrect block height")
 	errChildBlockEarlierThanParent           = errors.New("proposed timestamp before current chain time")
 	errOptionBlockTimestampNotMatchingParent = errors.New("option block proposed timestamp not matching parent block one")
+	errOptionBlockWithNonProposalParent      = errors.New("option block's parent is not a proposal block")
 )
 
+// verificationFailureReasons maps the known causes of a block failing
+// verification to the label reported in the verification failure metric.
+var verificationFailureReasons = []struct {
+	err    error
+	reason string
+}{
+	{err: errConflictingParentTxs, reason: "conflicting_parent_txs"},
+	{err: ErrConflictingBlockTxs, reason: "conflicting_block_txs"},
+	{err: state.ErrMissingParentState, reason: "missing_parent_state"},
+	{err: errApricotBlockIssuedAfterFork, reason: "apricot_block_issued_after_fork"},
+	{err: errBanffStandardBlockWithoutChanges, reason: "banff_standard_block_without_changes"},
+	{err: errIncorrectBlockHeight, reason: "incorrect_block_height"},
+	{err: errChildBlockEarlierThanParent, reason: "child_block_earlier_than_parent"},
+	{err: errOptionBlockTimestampNotMatchingParent, reason: "option_block_timestamp_not_matching_parent"},
+	{err: errOptionBlockWithNonProposalParent, reason: "option_block_with_non_proposal_parent"},
+}
+
+// verificationFailureReason returns the metric label describing why a block
+// failed verification with [err]. Errors that aren't known verification
+// failures are reported as "other".
+func verificationFailureReason(err error) string {
+	for _, r := range verificationFailureReasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return "other"
+}
+
 // verifier handles the logic for verifying a block.
 type verifier struct {
 	*backend
@@ -90,7 +120,8 @@ func (v *verifier) BanffProposalBlock(b *block.BanffProposalBlock) error {
 	}
 
 	return v.proposalBlock(
-		&b.ApricotProposalBlock,
+		b,
+		b.Tx,
 		onDecisionState,
 		onCommitState,
 		onAbortState,
@@ -165,7 +196,7 @@ func (v *verifier) ApricotProposalBlock(b *block.ApricotProposalBlock) error {
 		timestamp     = onCommitState.GetTimestamp() // Equal to parent timestamp
 		feeCalculator = state.NewStaticFeeCalculator(v.txExecutorBackend.Config, timestamp)
 	)
-	return v.proposalBlock(b, nil, onCommitState, onAbortState, feeCalculator, nil, nil, nil)
+	return v.proposalBlock(b, b.Tx, nil, onCommitState, onAbortState, feeCalculator, nil, nil, nil)
 }
 
 func (v *verifier) ApricotStandardBlock(b *block.ApricotStandardBlock) error {
@@ -342,7 +373,7 @@ func (v *verifier) abortBlock(b block.Block) error {
 	parentID := b.Parent()
 	onAbortState, ok := v.getOnAbortState(parentID)
 	if !ok {
-		return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
+		return v.missingProposalStateError(parentID)
 	}
 
 	blkID := b.ID()
@@ -360,7 +391,7 @@ func (v *verifier) commitBlock(b block.Block) error {
 	parentID := b.Parent()
 	onCommitState, ok := v.getOnCommitState(parentID)
 	if !ok {
-		return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
+		return v.missingProposalStateError(parentID)
 	}
 
 	blkID := b.ID()
@@ -373,9 +404,37 @@ func (v *verifier) commitBlock(b block.Block) error {
 	return nil
 }
 
+// missingProposalStateError returns the error reported when an option block's
+// parent [parentID] doesn't have the states of a verified proposal block.
+//
+// Only proposal blocks can be followed by option blocks, so if the parent isn't
+// a proposal block, that is reported rather than its missing state.
+func (v *verifier) missingProposalStateError(parentID ids.ID) error {
+	parent, err := v.GetBlock(parentID)
+	if err != nil {
+		return err
+	}
+
+	if isProposalBlock(parent) {
+		return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
+	}
+	return fmt.Errorf("%w: parent %s is a %T", errOptionBlockWithNonProposalParent, parentID, parent)
+}
+
+// isProposalBlock returns true if [blk] can be followed by option blocks.
+func isProposalBlock(blk block.Block) bool {
+	switch blk.(type) {
+	case *block.ApricotProposalBlock, *block.BanffProposalBlock:
+		return true
+	default:
+		return false
+	}
+}
+
 // proposalBlock populates the state of this block if [nil] is returned
 func (v *verifier) proposalBlock(
-	b *block.ApricotProposalBlock,
+	b block.Block,
+	tx *txs.Tx,
 	onDecisionState state.Diff,
 	onCommitState state.Diff,
 	onAbortState state.Diff,
@@ -389,19 +448,19 @@ func (v *verifier) proposalBlock(
 		OnAbortState:  onAbortState,
 		Backend:       v.txExecutorBackend,
 		FeeCalculator: feeCalculator,
-		Tx:            b.Tx,
+		Tx:            tx,
 	}
 
-	if err := b.Tx.Unsigned.Visit(&txExecutor); err != nil {
-		txID := b.Tx.ID()
+	if err := tx.Unsigned.Visit(&txExecutor); err != nil {
+		txID := tx.ID()
 		v.MarkDropped(txID, err) // cache tx as dropped
 		return err
 	}
 
-	onCommitState.AddTx(b.Tx, status.Committed)
-	onAbortState.AddTx(b.Tx, status.Aborted)
+	onCommitState.AddTx(tx, status.Committed)
+	onAbortState.AddTx(tx, status.Aborted)
 
-	v.Mempool.Remove(b.Tx)
+	v.Mempool.Remove(tx)
 
 	blkID := b.ID()
 	v.blkIDToState[blkID] = &blockState{
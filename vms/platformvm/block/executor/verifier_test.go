@@ -5,9 +5,11 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/components/verify"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/config"
+	"github.com/CaiJiJi/avalanchego/vms/platformvm/metrics"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/status"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
@@ -115,6 +118,54 @@ func TestVerifierVisitProposalBlock(t *testing.T) {
 	require.NoError(blk.Verify(context.Background()))
 }
 
+func TestVerifierVisitBanffProposalBlock(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, nil, banff)
+
+	// Move the clock to the end of the genesis validation period so that the
+	// first genesis validator can be rewarded.
+	env.clk.Set(defaultValidateEndTime)
+
+	stakerIterator, err := env.state.GetCurrentStakerIterator()
+	require.NoError(err)
+	require.True(stakerIterator.Next())
+	stakerToReward := stakerIterator.Value()
+	stakerIterator.Release()
+
+	tx, err := newRewardValidatorTx(t, stakerToReward.TxID)
+	require.NoError(err)
+
+	parentID := env.state.GetLastAccepted()
+	parentBlk, err := env.state.GetStatelessBlock(parentID)
+	require.NoError(err)
+
+	banffBlk := newBanffProposalBlock(t, env, parentID, parentBlk.Height()+1, tx)
+	require.Equal(stakerToReward.EndTime, banffBlk.Timestamp())
+
+	// Visit the block
+	blk := env.blkManager.NewBlock(banffBlk)
+	require.NoError(blk.Verify(context.Background()))
+
+	manager := env.blkManager.(*manager)
+	require.Contains(manager.backend.blkIDToState, banffBlk.ID())
+	gotBlkState := manager.backend.blkIDToState[banffBlk.ID()]
+	require.Equal(banffBlk, gotBlkState.statelessBlock)
+	require.Equal(banffBlk.Timestamp(), gotBlkState.timestamp)
+
+	// Assert that the expected tx statuses are set.
+	_, gotStatus, err := gotBlkState.onCommitState.GetTx(tx.ID())
+	require.NoError(err)
+	require.Equal(status.Committed, gotStatus)
+
+	_, gotStatus, err = gotBlkState.onAbortState.GetTx(tx.ID())
+	require.NoError(err)
+	require.Equal(status.Aborted, gotStatus)
+
+	// Visiting again should return nil without using dependencies.
+	require.NoError(blk.Verify(context.Background()))
+}
+
 func TestVerifierVisitAtomicBlock(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -197,6 +248,10 @@ func TestVerifierVisitAtomicBlock(t *testing.T) {
 	require.Equal(inputs, gotBlkState.inputs)
 	require.Equal(timestamp, gotBlkState.timestamp)
 
+	gotInputs, err := manager.AtomicInputs(apricotBlk.ID())
+	require.NoError(err)
+	require.Equal(inputs, gotInputs)
+
 	// Visiting again should return nil without using dependencies.
 	require.NoError(blk.Verify(context.Background()))
 }
@@ -774,6 +829,142 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 	require.ErrorIs(err, errConflictingParentTxs)
 }
 
+func TestBlockVerifyMarksFailureReason(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// Create mocked dependencies.
+	s := state.NewMockState(ctrl)
+	mempool := mempool.NewMockMempool(ctrl)
+
+	grandParentID := ids.GenerateTestID()
+	grandParentStatelessBlk := block.NewMockBlock(ctrl)
+	grandParentState := state.NewMockDiff(ctrl)
+	parentID := ids.GenerateTestID()
+	parentStatelessBlk := block.NewMockBlock(ctrl)
+	parentState := state.NewMockDiff(ctrl)
+	atomicInputs := set.Of(ids.GenerateTestID())
+
+	registerer := prometheus.NewRegistry()
+	m, err := metrics.New(registerer)
+	require.NoError(err)
+
+	manager := &manager{
+		backend: &backend{
+			blkIDToState: map[ids.ID]*blockState{
+				grandParentID: {
+					statelessBlock: grandParentStatelessBlk,
+					onAcceptState:  grandParentState,
+					inputs:         atomicInputs,
+				},
+				parentID: {
+					statelessBlock: parentStatelessBlk,
+					onAcceptState:  parentState,
+				},
+			},
+			Mempool: mempool,
+			state:   s,
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+		txExecutorBackend: &executor.Backend{
+			Config: &config.Config{
+				UpgradeConfig: upgrade.Config{
+					ApricotPhase5Time: time.Now().Add(time.Hour),
+					BanffTime:         mockable.MaxTime, // banff is not activated
+				},
+			},
+			Clk: &mockable.Clock{},
+		},
+		metrics: m,
+	}
+
+	blkTx := txs.NewMockUnsignedTx(ctrl)
+	blkTx.EXPECT().Visit(gomock.AssignableToTypeOf(&executor.StandardTxExecutor{})).DoAndReturn(
+		func(e *executor.StandardTxExecutor) error {
+			e.OnAccept = func() {}
+			e.Inputs = atomicInputs
+			e.AtomicRequests = map[ids.ID]*atomic.Requests{}
+			return nil
+		},
+	).Times(1)
+
+	// We can't serialize [blkTx] because it isn't
+	// registered with the blocks.Codec.
+	// Serialize this block with a dummy tx
+	// and replace it after creation with the mock tx.
+	// TODO allow serialization of mock txs.
+	statelessBlk, err := block.NewApricotStandardBlock(
+		parentID,
+		2,
+		[]*txs.Tx{
+			{
+				Unsigned: &txs.AdvanceTimeTx{},
+				Creds:    []verify.Verifiable{},
+			},
+		},
+	)
+	require.NoError(err)
+	statelessBlk.Transactions[0].Unsigned = blkTx
+
+	// Set expectations for dependencies.
+	timestamp := time.Now()
+	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
+	parentState.EXPECT().GetTimestamp().Return(timestamp).Times(1)
+	parentState.EXPECT().GetFeeState().Return(fee.State{}).Times(1)
+	parentStatelessBlk.EXPECT().Parent().Return(grandParentID).Times(1)
+
+	blk := manager.NewBlock(statelessBlk)
+	err = blk.Verify(context.Background())
+	require.ErrorIs(err, errConflictingParentTxs)
+
+	metricFamilies, err := registerer.Gather()
+	require.NoError(err)
+
+	failures := make(map[string]float64)
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "blks_verification_failed" {
+			continue
+		}
+		for _, metric := range metricFamily.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				failures[label.GetValue()] = metric.GetCounter().GetValue()
+			}
+		}
+	}
+	require.Equal(map[string]float64{"conflicting_parent_txs": 1}, failures)
+}
+
+func TestVerificationFailureReason(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedReason string
+	}{
+		{
+			name:           "known error",
+			err:            ErrConflictingBlockTxs,
+			expectedReason: "conflicting_block_txs",
+		},
+		{
+			name:           "wrapped known error",
+			err:            fmt.Errorf("%w: %s", state.ErrMissingParentState, ids.GenerateTestID()),
+			expectedReason: "missing_parent_state",
+		},
+		{
+			name:           "unknown error",
+			err:            database.ErrNotFound,
+			expectedReason: "other",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expectedReason, verificationFailureReason(test.err))
+		})
+	}
+}
+
 func TestVerifierVisitApricotStandardBlockWithProposalBlockParent(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -898,8 +1089,16 @@ func TestVerifierVisitApricotCommitBlockUnexpectedParentState(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	parentID := ids.GenerateTestID()
-	parentStatelessBlk := block.NewMockBlock(ctrl)
+	parentStatelessBlk, err := block.NewApricotProposalBlock(
+		ids.GenerateTestID(),
+		1,
+		&txs.Tx{
+			Unsigned: &txs.AdvanceTimeTx{},
+			Creds:    []verify.Verifiable{},
+		},
+	)
+	require.NoError(err)
+	parentID := parentStatelessBlk.ID()
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
@@ -928,9 +1127,6 @@ func TestVerifierVisitApricotCommitBlockUnexpectedParentState(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expectations for dependencies.
-	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
-
 	// Verify the block.
 	err = verifier.ApricotCommitBlock(blk)
 	require.ErrorIs(err, state.ErrMissingParentState)
@@ -942,9 +1138,19 @@ func TestVerifierVisitBanffCommitBlockUnexpectedParentState(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	parentID := ids.GenerateTestID()
-	parentStatelessBlk := block.NewMockBlock(ctrl)
 	timestamp := time.Unix(12345, 0)
+	parentStatelessBlk, err := block.NewBanffProposalBlock(
+		timestamp,
+		ids.GenerateTestID(),
+		1,
+		&txs.Tx{
+			Unsigned: &txs.AdvanceTimeTx{},
+			Creds:    []verify.Verifiable{},
+		},
+		nil,
+	)
+	require.NoError(err)
+	parentID := parentStatelessBlk.ID()
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
@@ -975,9 +1181,6 @@ func TestVerifierVisitBanffCommitBlockUnexpectedParentState(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expectations for dependencies.
-	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
-
 	// Verify the block.
 	err = verifier.BanffCommitBlock(blk)
 	require.ErrorIs(err, state.ErrMissingParentState)
@@ -989,8 +1192,16 @@ func TestVerifierVisitApricotAbortBlockUnexpectedParentState(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	parentID := ids.GenerateTestID()
-	parentStatelessBlk := block.NewMockBlock(ctrl)
+	parentStatelessBlk, err := block.NewApricotProposalBlock(
+		ids.GenerateTestID(),
+		1,
+		&txs.Tx{
+			Unsigned: &txs.AdvanceTimeTx{},
+			Creds:    []verify.Verifiable{},
+		},
+	)
+	require.NoError(err)
+	parentID := parentStatelessBlk.ID()
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
@@ -1019,9 +1230,6 @@ func TestVerifierVisitApricotAbortBlockUnexpectedParentState(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expectations for dependencies.
-	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
-
 	// Verify the block.
 	err = verifier.ApricotAbortBlock(blk)
 	require.ErrorIs(err, state.ErrMissingParentState)
@@ -1033,9 +1241,19 @@ func TestVerifierVisitBanffAbortBlockUnexpectedParentState(t *testing.T) {
 
 	// Create mocked dependencies.
 	s := state.NewMockState(ctrl)
-	parentID := ids.GenerateTestID()
-	parentStatelessBlk := block.NewMockBlock(ctrl)
 	timestamp := time.Unix(12345, 0)
+	parentStatelessBlk, err := block.NewBanffProposalBlock(
+		timestamp,
+		ids.GenerateTestID(),
+		1,
+		&txs.Tx{
+			Unsigned: &txs.AdvanceTimeTx{},
+			Creds:    []verify.Verifiable{},
+		},
+		nil,
+	)
+	require.NoError(err)
+	parentID := parentStatelessBlk.ID()
 	verifier := &verifier{
 		txExecutorBackend: &executor.Backend{
 			Config: &config.Config{
@@ -1066,10 +1284,55 @@ func TestVerifierVisitBanffAbortBlockUnexpectedParentState(t *testing.T) {
 	)
 	require.NoError(err)
 
-	// Set expectations for dependencies.
-	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
-
 	// Verify the block.
 	err = verifier.BanffAbortBlock(blk)
 	require.ErrorIs(err, state.ErrMissingParentState)
 }
+
+func TestVerifierVisitCommitBlockWithNonProposalParent(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// Create mocked dependencies.
+	s := state.NewMockState(ctrl)
+	parentStatelessBlk, err := block.NewApricotStandardBlock(
+		ids.GenerateTestID(),
+		1,
+		nil,
+	)
+	require.NoError(err)
+	parentID := parentStatelessBlk.ID()
+	parentOnAcceptState := state.NewMockDiff(ctrl)
+	verifier := &verifier{
+		txExecutorBackend: &executor.Backend{
+			Config: &config.Config{
+				UpgradeConfig: upgrade.Config{
+					BanffTime: mockable.MaxTime, // banff is not activated
+				},
+			},
+			Clk: &mockable.Clock{},
+		},
+		backend: &backend{
+			blkIDToState: map[ids.ID]*blockState{
+				parentID: {
+					statelessBlock: parentStatelessBlk,
+					onAcceptState:  parentOnAcceptState,
+				},
+			},
+			state: s,
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	blk, err := block.NewApricotCommitBlock(
+		parentID,
+		2,
+	)
+	require.NoError(err)
+
+	// Verify the block.
+	err = verifier.ApricotCommitBlock(blk)
+	require.ErrorIs(err, errOptionBlockWithNonProposalParent)
+}
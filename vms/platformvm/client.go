@@ -70,6 +70,9 @@ type Client interface {
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
 	// GetCurrentSupply returns an upper bound on the supply of AVAX in the system along with the P-chain height
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetValidatorUptime returns the uptime of [nodeID] as a validator of
+	// [subnetID], as observed by the node serving the request
+	GetValidatorUptime(ctx context.Context, nodeID ids.NodeID, subnetID ids.ID, options ...rpc.Option) (*GetValidatorUptimeReply, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
@@ -109,6 +112,9 @@ type Client interface {
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// GetFeeHistory returns the gas prices of up to [numBlocks] recently
+	// accepted blocks, from newest to oldest
+	GetFeeHistory(ctx context.Context, numBlocks uint64, options ...rpc.Option) ([]uint64, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// subnet at the specified height.
 	GetValidatorsAt(
@@ -320,6 +326,15 @@ func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) GetValidatorUptime(ctx context.Context, nodeID ids.NodeID, subnetID ids.ID, options ...rpc.Option) (*GetValidatorUptimeReply, error) {
+	res := &GetValidatorUptimeReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorUptime", &GetValidatorUptimeArgs{
+		NodeID:   nodeID,
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "platform.sampleValidators", &SampleValidatorsArgs{
@@ -478,6 +493,21 @@ func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.
 	return res.Timestamp, err
 }
 
+func (c *client) GetFeeHistory(ctx context.Context, numBlocks uint64, options ...rpc.Option) ([]uint64, error) {
+	res := &GetFeeHistoryReply{}
+	err := c.requester.SendRequest(ctx, "platform.getFeeHistory", &GetFeeHistoryArgs{
+		NumBlocks: json.Uint64(numBlocks),
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	gasPrices := make([]uint64, len(res.GasPrices))
+	for i, gasPrice := range res.GasPrices {
+		gasPrices[i] = uint64(gasPrice)
+	}
+	return gasPrices, nil
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -39,6 +39,19 @@ type Config struct {
 
 	// Dynamic fees are active after the E-upgrade
 	DynamicFeeConfig feecomponent.Config
+	// Gas price that dynamic fees never go below, even if DynamicFeeConfig
+	// allows a lower price. After the E-upgrade, this node doesn't add txs to
+	// its mempool if they pay less than this price per unit of gas. A floor of
+	// 0 doesn't restrict the mempool.
+	GasPriceFloor feecomponent.GasPrice
+	// Fraction of DynamicFeeConfig.MaxGasCapacity above which the gas consumed
+	// by an accepted block is reported as near capacity. A threshold of 0
+	// disables the report.
+	NearGasCapacityThreshold float64
+	// Maximum number of txs this node packs into a standard block that it
+	// builds. Blocks built by other nodes aren't restricted, as this limit is
+	// local to this node. A max of 0 allows any number of txs.
+	MaxTxsPerBlock int
 
 	// Provides access to the uptime manager as a thread safe data structure
 	UptimeLockedCalculator uptime.LockedCalculator
@@ -21,6 +21,10 @@ type Metrics interface {
 
 	// Mark that the given block was accepted.
 	MarkAccepted(block.Block) error
+	// Mark that a block failed verification for the given reason.
+	MarkVerificationFailed(reason string)
+	// Mark that a block consumed gas near the maximum gas capacity.
+	MarkNearGasCapacity()
 	// Mark that a validator set was created.
 	IncValidatorSetsCreated()
 	// Mark that a validator set was cached.
@@ -44,6 +48,17 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 	blockMetrics, err := newBlockMetrics(registerer)
 	m := &metrics{
 		blockMetrics: blockMetrics,
+		blksVerificationFailed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "blks_verification_failed",
+				Help: "number of blocks that failed verification",
+			},
+			[]string{"reason"},
+		),
+		blksNearGasCapacity: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "blks_near_gas_capacity",
+			Help: "number of accepted blocks that consumed gas above the near capacity threshold",
+		}),
 		timeUntilUnstake: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "time_until_unstake",
 			Help: "Time (in ns) until this node leaves the Primary Network's validator set",
@@ -87,6 +102,8 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 	errs.Add(err)
 	m.APIInterceptor = apiRequestMetrics
 	errs.Add(
+		registerer.Register(m.blksVerificationFailed),
+		registerer.Register(m.blksNearGasCapacity),
 		registerer.Register(m.timeUntilUnstake),
 		registerer.Register(m.timeUntilSubnetUnstake),
 		registerer.Register(m.localStake),
@@ -104,7 +121,9 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 type metrics struct {
 	metric.APIInterceptor
 
-	blockMetrics *blockMetrics
+	blockMetrics           *blockMetrics
+	blksVerificationFailed *prometheus.CounterVec
+	blksNearGasCapacity    prometheus.Counter
 
 	timeUntilUnstake       prometheus.Gauge
 	timeUntilSubnetUnstake *prometheus.GaugeVec
@@ -121,6 +140,14 @@ func (m *metrics) MarkAccepted(b block.Block) error {
 	return b.Visit(m.blockMetrics)
 }
 
+func (m *metrics) MarkVerificationFailed(reason string) {
+	m.blksVerificationFailed.WithLabelValues(reason).Inc()
+}
+
+func (m *metrics) MarkNearGasCapacity() {
+	m.blksNearGasCapacity.Inc()
+}
+
 func (m *metrics) IncValidatorSetsCreated() {
 	m.validatorSetsCreated.Inc()
 }
@@ -25,6 +25,10 @@ func (noopMetrics) MarkAccepted(block.Block) error {
 	return nil
 }
 
+func (noopMetrics) MarkVerificationFailed(string) {}
+
+func (noopMetrics) MarkNearGasCapacity() {}
+
 func (noopMetrics) InterceptRequest(i *rpc.RequestInfo) *http.Request {
 	return i.Request
 }
@@ -63,6 +63,7 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errUptimeNotTracked           = errors.New("uptime is not tracked")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -943,6 +944,67 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 	return nil
 }
 
+// GetValidatorUptimeArgs are the arguments for calling GetValidatorUptime
+type GetValidatorUptimeArgs struct {
+	NodeID   ids.NodeID `json:"nodeID"`
+	SubnetID ids.ID     `json:"subnetID"`
+}
+
+// GetValidatorUptimeReply are the results from calling GetValidatorUptime
+type GetValidatorUptimeReply struct {
+	// Uptime is the fraction, in [0, 1], of the tracked duration that the
+	// validator was online.
+	Uptime avajson.Float32 `json:"uptime"`
+	// UpDuration is the number of seconds the validator was online.
+	UpDuration avajson.Uint64 `json:"upDuration"`
+	// TrackedDuration is the number of seconds since the validator's start
+	// time.
+	TrackedDuration avajson.Uint64 `json:"trackedDuration"`
+}
+
+// GetValidatorUptime returns the uptime of a current validator of a subnet as
+// observed by this node.
+func (s *Service) GetValidatorUptime(_ *http.Request, args *GetValidatorUptimeArgs, reply *GetValidatorUptimeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorUptime"),
+		zap.Stringer("nodeID", args.NodeID),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	// Only report uptimes that we have been actively tracking.
+	if constants.PrimaryNetworkID != args.SubnetID && !s.vm.TrackedSubnets.Contains(args.SubnetID) {
+		return fmt.Errorf("%w for subnet %s", errUptimeNotTracked, args.SubnetID)
+	}
+
+	staker, err := s.vm.state.GetCurrentValidator(args.SubnetID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get current validator %s of subnet %s: %w", args.NodeID, args.SubnetID, err)
+	}
+
+	upDuration, now, err := s.vm.uptimeManager.CalculateUptime(args.NodeID, args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate uptime: %w", err)
+	}
+	uptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(args.NodeID, args.SubnetID, staker.StartTime)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate uptime: %w", err)
+	}
+
+	var trackedDuration time.Duration
+	if now.After(staker.StartTime) {
+		trackedDuration = now.Sub(staker.StartTime)
+	}
+
+	reply.Uptime = avajson.Float32(uptime)
+	reply.UpDuration = avajson.Uint64(upDuration / time.Second)
+	reply.TrackedDuration = avajson.Uint64(trackedDuration / time.Second)
+	return nil
+}
+
 // GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
 type GetCurrentSupplyArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
@@ -1578,13 +1640,21 @@ type GetTotalStakeReply struct {
 	Stake avajson.Uint64 `json:"stake"`
 
 	Weight avajson.Uint64 `json:"weight"`
+	// ValidatorWeight is the weight staked by the current validators of the
+	// subnet, excluding their delegators
+	ValidatorWeight avajson.Uint64 `json:"validatorWeight"`
+	// DelegatorWeight is the weight delegated to the current validators of
+	// the subnet
+	DelegatorWeight avajson.Uint64 `json:"delegatorWeight"`
 }
 
-// GetTotalStake returns the total amount staked on the Primary Network
+// GetTotalStake returns the total amount staked on the requested subnet, along
+// with how much of it is staked by validators and by delegators.
 func (s *Service) GetTotalStake(_ *http.Request, args *GetTotalStakeArgs, reply *GetTotalStakeReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getTotalStake"),
+		zap.Stringer("subnetID", args.SubnetID),
 	)
 
 	totalWeight, err := s.vm.Validators.TotalWeight(args.SubnetID)
@@ -1594,6 +1664,34 @@ func (s *Service) GetTotalStake(_ *http.Request, args *GetTotalStakeArgs, reply
 	weight := avajson.Uint64(totalWeight)
 	reply.Weight = weight
 	reply.Stake = weight
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer currentStakerIterator.Release()
+
+	var validatorWeight, delegatorWeight uint64
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != args.SubnetID {
+			continue
+		}
+
+		if staker.Priority.IsValidator() {
+			validatorWeight, err = safemath.Add(validatorWeight, staker.Weight)
+		} else {
+			delegatorWeight, err = safemath.Add(delegatorWeight, staker.Weight)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	reply.ValidatorWeight = avajson.Uint64(validatorWeight)
+	reply.DelegatorWeight = avajson.Uint64(delegatorWeight)
 	return nil
 }
 
@@ -1661,6 +1759,36 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	return nil
 }
 
+// GetFeeHistoryArgs are the arguments for calling GetFeeHistory
+type GetFeeHistoryArgs struct {
+	// Maximum number of gas prices to return
+	NumBlocks avajson.Uint64 `json:"numBlocks"`
+}
+
+// GetFeeHistoryReply is the response from GetFeeHistory
+type GetFeeHistoryReply struct {
+	// Gas prices of recently accepted blocks, from newest to oldest
+	GasPrices []avajson.Uint64 `json:"gasPrices"`
+}
+
+// GetFeeHistory returns the gas prices of recently accepted blocks.
+func (s *Service) GetFeeHistory(_ *http.Request, args *GetFeeHistoryArgs, reply *GetFeeHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeHistory"),
+	)
+
+	// The manager only retains a bounded number of gas prices, so capping
+	// [NumBlocks] prevents an overflow when converting it to an int.
+	numBlocks := min(uint64(args.NumBlocks), math.MaxInt32)
+	gasPrices := s.vm.manager.FeeHistory(int(numBlocks))
+	reply.GasPrices = make([]avajson.Uint64, len(gasPrices))
+	for i, gasPrice := range gasPrices {
+		reply.GasPrices[i] = avajson.Uint64(gasPrice)
+	}
+	return nil
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   avajson.Uint64 `json:"height"`
@@ -33,6 +33,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/formatting"
 	"github.com/CaiJiJi/avalanchego/utils/logging"
 	"github.com/CaiJiJi/avalanchego/vms/components/avax"
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/block"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/signer"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/state"
@@ -592,6 +593,53 @@ func TestGetStake(t *testing.T) {
 	require.Equal(stakeAmount+oldStake, outputs[0].Out.Amount()+outputs[1].Out.Amount()+outputs[2].Out.Amount())
 }
 
+func TestGetTotalStake(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	var (
+		subnetID = ids.GenerateTestID()
+		nodeID   = ids.GenerateTestNodeID()
+	)
+	newStaker := func(weight uint64, priority txs.Priority) *state.Staker {
+		return &state.Staker{
+			TxID:      ids.GenerateTestID(),
+			NodeID:    nodeID,
+			SubnetID:  subnetID,
+			Weight:    weight,
+			StartTime: defaultValidateStartTime,
+			EndTime:   defaultValidateEndTime,
+			NextTime:  defaultValidateEndTime,
+			Priority:  priority,
+		}
+	}
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.PutCurrentValidator(newStaker(100, txs.SubnetPermissionlessValidatorCurrentPriority))
+	service.vm.state.PutCurrentDelegator(newStaker(20, txs.SubnetPermissionlessDelegatorCurrentPriority))
+	service.vm.state.PutCurrentDelegator(newStaker(30, txs.SubnetPermissionlessDelegatorCurrentPriority))
+	require.NoError(service.vm.state.Commit())
+	service.vm.ctx.Lock.Unlock()
+
+	reply := GetTotalStakeReply{}
+	require.NoError(service.GetTotalStake(nil, &GetTotalStakeArgs{
+		SubnetID: subnetID,
+	}, &reply))
+	require.Equal(GetTotalStakeReply{
+		Stake:           150,
+		Weight:          150,
+		ValidatorWeight: 100,
+		DelegatorWeight: 50,
+	}, reply)
+
+	// The stakers of other subnets aren't included.
+	reply = GetTotalStakeReply{}
+	require.NoError(service.GetTotalStake(nil, &GetTotalStakeArgs{
+		SubnetID: ids.GenerateTestID(),
+	}, &reply))
+	require.Equal(GetTotalStakeReply{}, reply)
+}
+
 func TestGetCurrentValidators(t *testing.T) {
 	require := require.New(t)
 	service, _, factory := defaultService(t)
@@ -747,6 +795,68 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestGetFeeHistory(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	manager := blockexecutor.NewMockManager(ctrl)
+	manager.EXPECT().FeeHistory(2).Return([]fee.GasPrice{3, 1})
+	service := &Service{
+		vm: &VM{
+			manager: manager,
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	reply := GetFeeHistoryReply{}
+	require.NoError(service.GetFeeHistory(nil, &GetFeeHistoryArgs{
+		NumBlocks: 2,
+	}, &reply))
+	require.Equal([]avajson.Uint64{3, 1}, reply.GasPrices)
+}
+
+func TestGetValidatorUptime(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	nodeID := genesisNodeIDs[0]
+
+	service.vm.ctx.Lock.Lock()
+	// The genesis validators are tracked once normal operations start, so the
+	// validator is only credited for the time it is connected from now on.
+	require.NoError(service.vm.uptimeManager.Connect(nodeID, constants.PrimaryNetworkID))
+	staker, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	now := service.vm.clock.Time().Add(time.Hour)
+	service.vm.clock.Set(now)
+	service.vm.ctx.Lock.Unlock()
+
+	reply := GetValidatorUptimeReply{}
+	require.NoError(service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   nodeID,
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply))
+
+	expectedDuration := avajson.Uint64(now.Sub(staker.StartTime) / time.Second)
+	require.Equal(expectedDuration, reply.TrackedDuration)
+	require.Equal(expectedDuration, reply.UpDuration)
+	require.InDelta(1, float64(reply.Uptime), 0)
+
+	err = service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   ids.GenerateTestNodeID(),
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	err = service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   nodeID,
+		SubnetID: ids.GenerateTestID(),
+	}, &reply)
+	require.ErrorIs(err, errUptimeNotTracked)
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -22,12 +22,35 @@ var (
 	_ Versions = stateGetter{}
 
 	ErrMissingParentState = errors.New("missing parent state")
+
+	errUTXOMisindexed = errors.New("utxo is indexed under a different ID")
+	errTxMisindexed   = errors.New("tx is indexed under a different ID")
 )
 
 type Diff interface {
 	Chain
 
-	Apply(Chain) error
+	Apply(Chain, ...ApplyOption) error
+
+	// Validate checks that the modifications recorded in the diff are
+	// internally consistent. Callers that want to guard against applying a
+	// corrupted diff should apply it WithValidation.
+	Validate() error
+}
+
+// ApplyOption configures how a diff is applied.
+type ApplyOption func(*applyConfig)
+
+type applyConfig struct {
+	validate bool
+}
+
+// WithValidation validates the diff before applying it. If the diff is
+// invalid, the error is returned and the base state isn't modified.
+func WithValidation() ApplyOption {
+	return func(c *applyConfig) {
+		c.validate = true
+	}
 }
 
 type diff struct {
@@ -433,7 +456,17 @@ func (d *diff) DeleteUTXO(utxoID ids.ID) {
 	}
 }
 
-func (d *diff) Apply(baseState Chain) error {
+func (d *diff) Apply(baseState Chain, options ...ApplyOption) error {
+	config := applyConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+	if config.validate {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+
 	baseState.SetTimestamp(d.timestamp)
 	baseState.SetFeeState(d.feeState)
 	for subnetID, supply := range d.currentSupply {
@@ -520,3 +553,35 @@ func (d *diff) Apply(baseState Chain) error {
 	}
 	return nil
 }
+
+func (d *diff) Validate() error {
+	if err := d.currentStakerDiffs.validate(true); err != nil {
+		return fmt.Errorf("invalid current stakers: %w", err)
+	}
+	if err := d.pendingStakerDiffs.validate(false); err != nil {
+		return fmt.Errorf("invalid pending stakers: %w", err)
+	}
+	for utxoID, utxo := range d.modifiedUTXOs {
+		if utxo == nil {
+			continue
+		}
+		// The input ID cached by the UTXO may be stale, so it is recomputed.
+		if inputID := utxo.TxID.Prefix(uint64(utxo.OutputIndex)); inputID != utxoID {
+			return fmt.Errorf("%w: utxo %s stored as %s",
+				errUTXOMisindexed,
+				inputID,
+				utxoID,
+			)
+		}
+	}
+	for txID, tx := range d.addedTxs {
+		if actualTxID := tx.tx.ID(); actualTxID != txID {
+			return fmt.Errorf("%w: tx %s stored as %s",
+				errTxMisindexed,
+				actualTxID,
+				txID,
+			)
+		}
+	}
+	return nil
+}
@@ -723,3 +723,155 @@ func TestDiffStacking(t *testing.T) {
 	require.NoError(err)
 	require.Equal(owner3, owner)
 }
+
+func TestDiffValidate(t *testing.T) {
+	var (
+		startTime = time.Unix(1_000, 0)
+		endTime   = startTime.Add(time.Hour)
+	)
+	newCurrentValidator := func() *Staker {
+		return &Staker{
+			TxID:      ids.GenerateTestID(),
+			NodeID:    ids.GenerateTestNodeID(),
+			SubnetID:  constants.PrimaryNetworkID,
+			StartTime: startTime,
+			EndTime:   endTime,
+			NextTime:  endTime,
+			Priority:  txs.PrimaryNetworkValidatorCurrentPriority,
+		}
+	}
+	newPendingDelegator := func() *Staker {
+		return &Staker{
+			TxID:      ids.GenerateTestID(),
+			NodeID:    ids.GenerateTestNodeID(),
+			SubnetID:  constants.PrimaryNetworkID,
+			StartTime: startTime,
+			EndTime:   endTime,
+			NextTime:  startTime,
+			Priority:  txs.PrimaryNetworkDelegatorBanffPendingPriority,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(Diff)
+		expectedErr error
+	}{
+		{
+			name:   "empty",
+			modify: func(Diff) {},
+		},
+		{
+			name: "consistent stakers",
+			modify: func(d Diff) {
+				d.PutCurrentValidator(newCurrentValidator())
+				d.PutPendingDelegator(newPendingDelegator())
+			},
+		},
+		{
+			name: "validator indexed under another node",
+			modify: func(d Diff) {
+				staker := newCurrentValidator()
+				d.PutCurrentValidator(staker)
+				staker.NodeID = ids.GenerateTestNodeID()
+			},
+			expectedErr: errStakerMisindexed,
+		},
+		{
+			name: "pending validator in current set",
+			modify: func(d Diff) {
+				staker := newCurrentValidator()
+				staker.Priority = txs.PrimaryNetworkValidatorPendingPriority
+				d.PutCurrentValidator(staker)
+			},
+			expectedErr: errUnexpectedPriority,
+		},
+		{
+			name: "delegator in validator position",
+			modify: func(d Diff) {
+				staker := newPendingDelegator()
+				staker.Priority = txs.PrimaryNetworkValidatorPendingPriority
+				d.PutPendingDelegator(staker)
+			},
+			expectedErr: errUnexpectedPriority,
+		},
+		{
+			name: "current validator next time is start time",
+			modify: func(d Diff) {
+				staker := newCurrentValidator()
+				staker.NextTime = staker.StartTime
+				d.PutCurrentValidator(staker)
+			},
+			expectedErr: errUnexpectedNextTime,
+		},
+		{
+			name: "deleted delegator ends before it starts",
+			modify: func(d Diff) {
+				staker := newPendingDelegator()
+				staker.EndTime = staker.StartTime.Add(-time.Second)
+				d.DeletePendingDelegator(staker)
+			},
+			expectedErr: errStakerEndsBeforeStart,
+		},
+		{
+			name: "utxo indexed under another ID",
+			modify: func(d Diff) {
+				utxo := &avax.UTXO{
+					UTXOID: avax.UTXOID{
+						TxID: ids.GenerateTestID(),
+					},
+				}
+				d.AddUTXO(utxo)
+				utxo.OutputIndex++
+			},
+			expectedErr: errUTXOMisindexed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := NewMockState(ctrl)
+			// Called in NewDiffOn
+			state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+			state.EXPECT().GetFeeState().Return(fee.State{}).Times(1)
+
+			d, err := NewDiffOn(state)
+			require.NoError(err)
+
+			test.modify(d)
+			err = d.Validate()
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestDiffApplyWithValidation(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	state := NewMockState(ctrl)
+	// Called in NewDiffOn
+	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(fee.State{}).Times(1)
+
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+
+	staker := &Staker{
+		TxID:      ids.GenerateTestID(),
+		NodeID:    ids.GenerateTestNodeID(),
+		SubnetID:  constants.PrimaryNetworkID,
+		StartTime: time.Unix(1_000, 0),
+		EndTime:   time.Unix(2_000, 0),
+		NextTime:  time.Unix(2_000, 0),
+		Priority:  txs.PrimaryNetworkValidatorCurrentPriority,
+	}
+	d.PutCurrentValidator(staker)
+	staker.NodeID = ids.GenerateTestNodeID()
+
+	// The invalid diff must not modify [state], which would fail the mock.
+	err = d.Apply(state, WithValidation())
+	require.ErrorIs(err, errStakerMisindexed)
+}
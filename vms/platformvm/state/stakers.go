@@ -4,12 +4,22 @@
 package state
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/google/btree"
 
 	"github.com/CaiJiJi/avalanchego/database"
 	"github.com/CaiJiJi/avalanchego/ids"
 )
 
+var (
+	errStakerMisindexed      = errors.New("staker is indexed under a different subnet or node")
+	errUnexpectedPriority    = errors.New("staker priority doesn't match its validator set")
+	errUnexpectedNextTime    = errors.New("staker next time doesn't match its validator set")
+	errStakerEndsBeforeStart = errors.New("staker ends before it starts")
+)
+
 type Stakers interface {
 	CurrentStakers
 	PendingStakers
@@ -95,6 +105,24 @@ type PendingStakers interface {
 	GetPendingStakerIterator() (StakerIterator, error)
 }
 
+// PutCurrentValidators adds all of the [stakers] describing validators to the
+// staker set.
+//
+// Invariant: None of the [stakers] are currently a CurrentValidator
+func PutCurrentValidators(s CurrentStakers, stakers []*Staker) {
+	for _, staker := range stakers {
+		s.PutCurrentValidator(staker)
+	}
+}
+
+// PutPendingValidators adds all of the [stakers] describing validators to the
+// staker set.
+func PutPendingValidators(s PendingStakers, stakers []*Staker) {
+	for _, staker := range stakers {
+		s.PutPendingValidator(staker)
+	}
+}
+
 type baseStakers struct {
 	// subnetID --> nodeID --> current state for the validator of the subnet
 	validators map[ids.ID]map[ids.NodeID]*baseStaker
@@ -396,3 +424,95 @@ func (s *diffStakers) getOrCreateDiff(subnetID ids.ID, nodeID ids.NodeID) *diffV
 	}
 	return validatorDiff
 }
+
+// validate checks that every staker in the diff is indexed under its own
+// subnet and node, and that its priority and next time match the validator
+// set the diff belongs to.
+func (s *diffStakers) validate(current bool) error {
+	for subnetID, subnetValidatorDiffs := range s.validatorDiffs {
+		for nodeID, validatorDiff := range subnetValidatorDiffs {
+			if validatorDiff.validatorStatus != unmodified {
+				if err := validateStaker(validatorDiff.validator, subnetID, nodeID, current, true); err != nil {
+					return err
+				}
+			}
+
+			addedDelegatorIterator := NewTreeIterator(validatorDiff.addedDelegators)
+			for addedDelegatorIterator.Next() {
+				if err := validateStaker(addedDelegatorIterator.Value(), subnetID, nodeID, current, false); err != nil {
+					addedDelegatorIterator.Release()
+					return err
+				}
+			}
+			addedDelegatorIterator.Release()
+
+			for _, delegator := range validatorDiff.deletedDelegators {
+				if err := validateStaker(delegator, subnetID, nodeID, current, false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateStaker(
+	staker *Staker,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	current bool,
+	validator bool,
+) error {
+	if staker.SubnetID != subnetID || staker.NodeID != nodeID {
+		return fmt.Errorf("%w: staker %s of subnet %s and node %s stored under subnet %s and node %s",
+			errStakerMisindexed,
+			staker.TxID,
+			staker.SubnetID,
+			staker.NodeID,
+			subnetID,
+			nodeID,
+		)
+	}
+
+	var expectedPriority bool
+	switch {
+	case current && validator:
+		expectedPriority = staker.Priority.IsCurrentValidator()
+	case current:
+		expectedPriority = staker.Priority.IsCurrentDelegator()
+	case validator:
+		expectedPriority = staker.Priority.IsPendingValidator()
+	default:
+		expectedPriority = staker.Priority.IsPendingDelegator()
+	}
+	if !expectedPriority {
+		return fmt.Errorf("%w: staker %s has priority %d",
+			errUnexpectedPriority,
+			staker.TxID,
+			staker.Priority,
+		)
+	}
+
+	if staker.EndTime.Before(staker.StartTime) {
+		return fmt.Errorf("%w: staker %s starts at %s and ends at %s",
+			errStakerEndsBeforeStart,
+			staker.TxID,
+			staker.StartTime,
+			staker.EndTime,
+		)
+	}
+
+	expectedNextTime := staker.StartTime
+	if current {
+		expectedNextTime = staker.EndTime
+	}
+	if !staker.NextTime.Equal(expectedNextTime) {
+		return fmt.Errorf("%w: staker %s has next time %s but expected %s",
+			errUnexpectedNextTime,
+			staker.TxID,
+			staker.NextTime,
+			expectedNextTime,
+		)
+	}
+	return nil
+}
@@ -704,6 +704,85 @@ func TestPersistStakers(t *testing.T) {
 	}
 }
 
+func TestPutValidators(t *testing.T) {
+	const numValidators = 50
+
+	tests := []struct {
+		name          string
+		newStaker     func(ids.ID, *txs.AddPermissionlessValidatorTx) (*Staker, error)
+		putValidators func(*state, []*Staker)
+		getValidator  func(*state, ids.ID, ids.NodeID) (*Staker, error)
+	}{
+		{
+			name: "current",
+			newStaker: func(txID ids.ID, utx *txs.AddPermissionlessValidatorTx) (*Staker, error) {
+				return NewCurrentStaker(txID, utx, utx.StartTime(), 0)
+			},
+			putValidators: func(s *state, stakers []*Staker) {
+				PutCurrentValidators(s, stakers)
+			},
+			getValidator: func(s *state, subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
+				return s.GetCurrentValidator(subnetID, nodeID)
+			},
+		},
+		{
+			name: "pending",
+			newStaker: func(txID ids.ID, utx *txs.AddPermissionlessValidatorTx) (*Staker, error) {
+				return NewPendingStaker(txID, utx)
+			},
+			putValidators: func(s *state, stakers []*Staker) {
+				PutPendingValidators(s, stakers)
+			},
+			getValidator: func(s *state, subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
+				return s.GetPendingValidator(subnetID, nodeID)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			s, db := newUninitializedState(require)
+
+			var (
+				startTime = time.Now().Unix()
+				endTime   = time.Now().Add(14 * 24 * time.Hour).Unix()
+				stakers   = make([]*Staker, numValidators)
+			)
+			for i := range stakers {
+				utx := createPermissionlessValidatorTx(require, constants.PrimaryNetworkID, txs.Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					Start:  uint64(startTime),
+					End:    uint64(endTime),
+					Wght:   1234,
+				})
+				tx := &txs.Tx{Unsigned: utx}
+				require.NoError(tx.Initialize(txs.Codec))
+
+				staker, err := test.newStaker(tx.ID(), utx)
+				require.NoError(err)
+				stakers[i] = staker
+
+				s.AddTx(tx, status.Committed) // this is currently needed to reload the staker
+			}
+
+			test.putValidators(s, stakers)
+			require.NoError(s.Commit())
+
+			// rebuild the state
+			rebuiltState := newStateFromDB(require, db)
+			require.NoError(rebuiltState.loadCurrentValidators())
+			require.NoError(rebuiltState.loadPendingValidators())
+
+			for _, staker := range stakers {
+				retrievedStaker, err := test.getValidator(rebuiltState, staker.SubnetID, staker.NodeID)
+				require.NoError(err)
+				require.Equal(staker, retrievedStaker)
+			}
+		})
+	}
+}
+
 func newInitializedState(require *require.Assertions) State {
 	s, _ := newUninitializedState(require)
 	initializeState(require, s)
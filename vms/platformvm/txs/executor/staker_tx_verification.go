@@ -41,6 +41,8 @@ var (
 	ErrDurangoUpgradeNotActive         = errors.New("attempting to use a Durango-upgrade feature prior to activation")
 	ErrAddValidatorTxPostDurango       = errors.New("AddValidatorTx is not permitted post-Durango")
 	ErrAddDelegatorTxPostDurango       = errors.New("AddDelegatorTx is not permitted post-Durango")
+
+	errUnknownSubnet = errors.New("unknown subnet")
 )
 
 // verifySubnetValidatorPrimaryNetworkRequirements verifies the primary
@@ -231,6 +233,14 @@ func verifyAddSubnetValidatorTx(
 		return err
 	}
 
+	// Report a missing subnet explicitly rather than surfacing the lookup
+	// failure of whichever check happens to run into it first.
+	if _, err := chainState.GetSubnetOwner(tx.SubnetValidator.Subnet); err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", errUnknownSubnet, tx.SubnetValidator.Subnet)
+	} else if err != nil {
+		return err
+	}
+
 	_, err := GetValidator(chainState, tx.SubnetValidator.Subnet, tx.Validator.NodeID)
 	if err == nil {
 		return fmt.Errorf(
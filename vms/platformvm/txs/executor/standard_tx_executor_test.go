@@ -890,6 +890,46 @@ func TestApricotStandardTxExecutorAddSubnetValidator(t *testing.T) {
 		err = tx.Unsigned.Visit(&executor)
 		require.ErrorIs(err, ErrDuplicateValidator)
 	}
+
+	{
+		// Case: Subnet doesn't exist
+		startTime := defaultValidateStartTime.Add(time.Second)
+		builder, signer := env.factory.NewWallet(testSubnet1ControlKeys[0], testSubnet1ControlKeys[1])
+		utx, err := builder.NewAddSubnetValidatorTx(
+			&txs.SubnetValidator{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+					Start:  uint64(startTime.Unix()),
+					End:    uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+					Wght:   defaultWeight,
+				},
+				Subnet: testSubnet1.ID(),
+			},
+		)
+		require.NoError(err)
+		tx, err := walletsigner.SignUnsigned(context.Background(), signer, utx)
+		require.NoError(err)
+
+		// The wallet can't sign for an unknown subnet, so point the signed tx
+		// at one after the fact.
+		addSubnetValidatorTx := tx.Unsigned.(*txs.AddSubnetValidatorTx)
+		addSubnetValidatorTx.SubnetValidator.Subnet = ids.GenerateTestID()
+		addSubnetValidatorTx.SyntacticallyVerified = false
+		require.NoError(tx.Initialize(txs.Codec))
+
+		onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+		require.NoError(err)
+
+		feeCalculator := state.PickFeeCalculator(env.config, onAcceptState)
+		executor := StandardTxExecutor{
+			Backend:       &env.backend,
+			State:         onAcceptState,
+			FeeCalculator: feeCalculator,
+			Tx:            tx,
+		}
+		err = tx.Unsigned.Visit(&executor)
+		require.ErrorIs(err, errUnknownSubnet)
+	}
 }
 
 func TestBanffStandardTxExecutorAddValidator(t *testing.T) {
@@ -37,6 +37,7 @@ var (
 		expectedStaticFeeErr  error
 		expectedComplexity    fee.Dimensions
 		expectedComplexityErr error
+		expectedGas           fee.Gas
 		expectedDynamicFee    uint64
 		expectedDynamicFeeErr error
 	}{
@@ -78,6 +79,7 @@ var (
 				fee.DBWrite:   IntrinsicAddPermissionlessValidatorTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + 2*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        2291,
 			expectedDynamicFee: 229_100,
 		},
 		{
@@ -90,6 +92,7 @@ var (
 				fee.DBWrite:   IntrinsicAddPermissionlessValidatorTxComplexities[fee.DBWrite] + 2*intrinsicInputDBWrite + 3*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        3148,
 			expectedDynamicFee: 314_800,
 		},
 		{
@@ -102,6 +105,7 @@ var (
 				fee.DBWrite:   IntrinsicAddPermissionlessDelegatorTxComplexities[fee.DBWrite] + 1*intrinsicInputDBWrite + 2*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        2099,
 			expectedDynamicFee: 209_900,
 		},
 		{
@@ -114,6 +118,7 @@ var (
 				fee.DBWrite:   IntrinsicAddPermissionlessDelegatorTxComplexities[fee.DBWrite] + 2*intrinsicInputDBWrite + 3*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        3120,
 			expectedDynamicFee: 312_000,
 		},
 		{
@@ -126,6 +131,7 @@ var (
 				fee.DBWrite:   IntrinsicAddSubnetValidatorTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1960,
 			expectedDynamicFee: 196_000,
 		},
 		{
@@ -138,6 +144,7 @@ var (
 				fee.DBWrite:   IntrinsicBaseTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + 2*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1499,
 			expectedDynamicFee: 149_900,
 		},
 		{
@@ -150,6 +157,7 @@ var (
 				fee.DBWrite:   IntrinsicCreateChainTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1809,
 			expectedDynamicFee: 180_900,
 		},
 		{
@@ -162,6 +170,7 @@ var (
 				fee.DBWrite:   IntrinsicCreateSubnetTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1439,
 			expectedDynamicFee: 143_900,
 		},
 		{
@@ -174,6 +183,7 @@ var (
 				fee.DBWrite:   IntrinsicExportTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + 2*intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1535,
 			expectedDynamicFee: 153_500,
 		},
 		{
@@ -186,6 +196,7 @@ var (
 				fee.DBWrite:   IntrinsicImportTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1135,
 			expectedDynamicFee: 113_500,
 		},
 		{
@@ -198,6 +209,7 @@ var (
 				fee.DBWrite:   IntrinsicRemoveSubnetValidatorTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1936,
 			expectedDynamicFee: 193_600,
 		},
 		{
@@ -217,6 +229,7 @@ var (
 				fee.DBWrite:   IntrinsicTransferSubnetOwnershipTxComplexities[fee.DBWrite] + intrinsicInputDBWrite + intrinsicOutputDBWrite,
 				fee.Compute:   0, // TODO: implement
 			},
+			expectedGas:        1736,
 			expectedDynamicFee: 173_600,
 		},
 	}
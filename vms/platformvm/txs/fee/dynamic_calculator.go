@@ -4,35 +4,104 @@
 package fee
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
 )
 
-var _ Calculator = (*dynamicCalculator)(nil)
+var (
+	_ Calculator     = (*dynamicCalculator)(nil)
+	_ json.Marshaler = (*dynamicCalculator)(nil)
+	_ fmt.Stringer   = (*dynamicCalculator)(nil)
+
+	ErrZeroGasPrice = errors.New("zero gas price")
+)
+
+// DynamicCalculatorOption configures a calculator returned by
+// NewDynamicCalculator.
+type DynamicCalculatorOption func(*dynamicCalculator)
+
+// WithTip adds [tip], in nAVAX, to every fee calculated by the calculator.
+func WithTip(tip uint64) DynamicCalculatorOption {
+	return func(c *dynamicCalculator) {
+		c.tip = tip
+	}
+}
 
 func NewDynamicCalculator(
 	weights fee.Dimensions,
 	price fee.GasPrice,
+	options ...DynamicCalculatorOption,
 ) Calculator {
-	return &dynamicCalculator{
+	c := &dynamicCalculator{
 		weights: weights,
 		price:   price,
 	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
 }
 
 type dynamicCalculator struct {
 	weights fee.Dimensions
 	price   fee.GasPrice
+	tip     uint64
+}
+
+// dynamicCalculatorJSON is the JSON representation of a dynamicCalculator.
+type dynamicCalculatorJSON struct {
+	Weights  fee.Dimensions `json:"weights"`
+	GasPrice fee.GasPrice   `json:"gasPrice"`
+	Tip      uint64         `json:"tip"`
+}
+
+// MarshalJSON exposes the weights and gas price of the calculator for
+// diagnostics.
+func (c *dynamicCalculator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dynamicCalculatorJSON{
+		Weights:  c.weights,
+		GasPrice: c.price,
+		Tip:      c.tip,
+	})
+}
+
+func (c *dynamicCalculator) String() string {
+	return fmt.Sprintf("weights: %v, gasPrice: %d, tip: %d", c.weights, c.price, c.tip)
 }
 
 func (c *dynamicCalculator) CalculateFee(tx txs.UnsignedTx) (uint64, error) {
-	complexity, err := TxComplexity(tx)
+	gas, err := txGas(tx, c.weights)
 	if err != nil {
 		return 0, err
 	}
-	gas, err := complexity.ToGas(c.weights)
+	return c.CalculateFeeWithTip(gas)
+}
+
+// CalculateFeeWithTip returns the cost of [gas] at the gas price of the
+// calculator plus the configured tip.
+func (c *dynamicCalculator) CalculateFeeWithTip(gas fee.Gas) (uint64, error) {
+	// Dynamic fees should never be free. A zero gas price implies that the
+	// calculator was not initialized correctly.
+	if gas > 0 && c.price == 0 {
+		return 0, fmt.Errorf("%w: can't charge for %d gas", ErrZeroGasPrice, gas)
+	}
+	return gas.CostWithTip(c.price, c.tip)
+}
+
+// ExpectedGas returns the amount of gas that [tx] consumes under [cfg], based
+// solely on the complexity of the tx.
+func ExpectedGas(tx *txs.Tx, cfg fee.Config) (fee.Gas, error) {
+	return txGas(tx.Unsigned, cfg.Weights)
+}
+
+func txGas(tx txs.UnsignedTx, weights fee.Dimensions) (fee.Gas, error) {
+	complexity, err := TxComplexity(tx)
 	if err != nil {
 		return 0, err
 	}
-	return gas.Cost(c.price)
+	return complexity.ToGas(weights)
 }
@@ -5,11 +5,17 @@ package fee
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/CaiJiJi/avalanchego/vms/components/fee"
 	"github.com/CaiJiJi/avalanchego/vms/platformvm/txs"
+
+	safemath "github.com/CaiJiJi/avalanchego/utils/math"
 )
 
 func TestDynamicCalculator(t *testing.T) {
@@ -30,3 +36,152 @@ func TestDynamicCalculator(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicCalculatorZeroGasPrice(t *testing.T) {
+	calculator := NewDynamicCalculator(testDynamicWeights, 0)
+	for _, test := range txTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			txBytes, err := hex.DecodeString(test.tx)
+			require.NoError(err)
+
+			tx, err := txs.Parse(txs.Codec, txBytes)
+			require.NoError(err)
+
+			expectedErr := test.expectedDynamicFeeErr
+			if expectedErr == nil {
+				expectedErr = ErrZeroGasPrice
+			}
+
+			fee, err := calculator.CalculateFee(tx.Unsigned)
+			require.Zero(fee)
+			require.ErrorIs(err, expectedErr)
+		})
+	}
+}
+
+func TestDynamicCalculatorWithTip(t *testing.T) {
+	const tip = 1_000
+	calculator := NewDynamicCalculator(testDynamicWeights, testDynamicPrice, WithTip(tip))
+	for _, test := range txTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			txBytes, err := hex.DecodeString(test.tx)
+			require.NoError(err)
+
+			tx, err := txs.Parse(txs.Codec, txBytes)
+			require.NoError(err)
+
+			fee, err := calculator.CalculateFee(tx.Unsigned)
+			require.ErrorIs(err, test.expectedDynamicFeeErr)
+			if test.expectedDynamicFeeErr != nil {
+				return
+			}
+			require.Equal(test.expectedDynamicFee+tip, fee)
+		})
+	}
+}
+
+func TestDynamicCalculatorCalculateFeeWithTip(t *testing.T) {
+	tests := []struct {
+		name        string
+		tip         uint64
+		gas         fee.Gas
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name:     "zero tip",
+			tip:      0,
+			gas:      10,
+			expected: 10 * testDynamicPrice,
+		},
+		{
+			name:     "positive tip",
+			tip:      7,
+			gas:      10,
+			expected: 10*testDynamicPrice + 7,
+		},
+		{
+			name:        "overflow",
+			tip:         math.MaxUint64,
+			gas:         10,
+			expectedErr: safemath.ErrOverflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			calculator := &dynamicCalculator{
+				weights: testDynamicWeights,
+				price:   testDynamicPrice,
+				tip:     test.tip,
+			}
+			fee, err := calculator.CalculateFeeWithTip(test.gas)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, fee)
+		})
+	}
+}
+
+func TestDynamicCalculatorMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		calculator     Calculator
+		expectedJSON   string
+		expectedString string
+	}{
+		{
+			name:           "zero value",
+			calculator:     &dynamicCalculator{},
+			expectedJSON:   `{"weights":[0,0,0,0],"gasPrice":0,"tip":0}`,
+			expectedString: "weights: [0 0 0 0], gasPrice: 0, tip: 0",
+		},
+		{
+			name:           "initialized",
+			calculator:     NewDynamicCalculator(fee.Dimensions{1, 2, 3, 4}, 5),
+			expectedJSON:   `{"weights":[1,2,3,4],"gasPrice":5,"tip":0}`,
+			expectedString: "weights: [1 2 3 4], gasPrice: 5, tip: 0",
+		},
+		{
+			name:           "with tip",
+			calculator:     NewDynamicCalculator(fee.Dimensions{1, 2, 3, 4}, 5, WithTip(6)),
+			expectedJSON:   `{"weights":[1,2,3,4],"gasPrice":5,"tip":6}`,
+			expectedString: "weights: [1 2 3 4], gasPrice: 5, tip: 6",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			calculatorJSON, err := json.Marshal(test.calculator)
+			require.NoError(err)
+			require.JSONEq(test.expectedJSON, string(calculatorJSON))
+			require.Equal(test.expectedString, fmt.Sprint(test.calculator))
+		})
+	}
+}
+
+func TestExpectedGas(t *testing.T) {
+	config := fee.Config{
+		Weights: testDynamicWeights,
+	}
+	for _, test := range txTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			txBytes, err := hex.DecodeString(test.tx)
+			require.NoError(err)
+
+			tx, err := txs.Parse(txs.Codec, txBytes)
+			require.NoError(err)
+
+			gas, err := ExpectedGas(tx, config)
+			require.ErrorIs(err, test.expectedComplexityErr)
+			require.Equal(test.expectedGas, gas)
+		})
+	}
+}
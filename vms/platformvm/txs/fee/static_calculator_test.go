@@ -30,3 +30,22 @@ func TestStaticCalculator(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticCalculatorZeroFees(t *testing.T) {
+	calculator := NewStaticCalculator(StaticConfig{})
+	for _, test := range txTests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			txBytes, err := hex.DecodeString(test.tx)
+			require.NoError(err)
+
+			tx, err := txs.Parse(txs.Codec, txBytes)
+			require.NoError(err)
+
+			fee, err := calculator.CalculateFee(tx.Unsigned)
+			require.Zero(fee)
+			require.ErrorIs(err, test.expectedStaticFeeErr)
+		})
+	}
+}
@@ -83,6 +83,21 @@ func (mr *MockMempoolMockRecorder) GetDropReason(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropReason", reflect.TypeOf((*MockMempool)(nil).GetDropReason), arg0)
 }
 
+// GetSpender mocks base method.
+func (m *MockMempool) GetSpender(arg0 ids.ID) (*txs.Tx, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpender", arg0)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpender indicates an expected call of GetSpender.
+func (mr *MockMempoolMockRecorder) GetSpender(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpender", reflect.TypeOf((*MockMempool)(nil).GetSpender), arg0)
+}
+
 // Iterate mocks base method.
 func (m *MockMempool) Iterate(arg0 func(*txs.Tx) bool) {
 	m.ctrl.T.Helper()
@@ -29,10 +29,10 @@ var (
 	errUnsignedChild            = errors.New("expected child to be signed")
 	errUnexpectedBlockType      = errors.New("unexpected proposer block type")
 	errInnerParentMismatch      = errors.New("inner parentID didn't match expected parent")
-	errTimeNotMonotonic         = errors.New("time must monotonically increase")
+	errTimeNotMonotonic         = block.ErrTimeNotMonotonic
 	errPChainHeightNotMonotonic = errors.New("non monotonically increasing P-chain height")
 	errPChainHeightNotReached   = errors.New("block P-chain height larger than current P-chain height")
-	errTimeTooAdvanced          = errors.New("time is too far advanced")
+	errTimeTooAdvanced          = block.ErrTimeTooAdvanced
 	errProposerWindowNotStarted = errors.New("proposer window hasn't started")
 	errUnexpectedProposer       = errors.New("unexpected proposer for current window")
 	errProposerMismatch         = errors.New("proposer mismatch")
@@ -110,14 +110,8 @@ func (p *postForkCommonComponents) Verify(
 		return errInnerParentMismatch
 	}
 
-	childTimestamp := child.Timestamp()
-	if childTimestamp.Before(parentTimestamp) {
-		return errTimeNotMonotonic
-	}
-
-	maxTimestamp := p.vm.Time().Add(maxSkew)
-	if childTimestamp.After(maxTimestamp) {
-		return errTimeTooAdvanced
+	if err := block.VerifyTimestamp(child.SignedBlock, parentTimestamp, p.vm.Time(), maxSkew); err != nil {
+		return err
 	}
 
 	// If the node is currently syncing - we don't assume that the P-chain has
@@ -158,7 +152,7 @@ func (p *postForkCommonComponents) Verify(
 		p.vm.ctx.Log.Debug("verified post-fork block",
 			zap.Stringer("blkID", child.ID()),
 			zap.Time("parentTimestamp", parentTimestamp),
-			zap.Time("blockTimestamp", childTimestamp),
+			zap.Time("blockTimestamp", child.Timestamp()),
 		)
 	}
 
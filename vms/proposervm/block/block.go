@@ -17,8 +17,13 @@ import (
 var (
 	_ SignedBlock = (*statelessBlock)(nil)
 
+	ErrTimeNotMonotonic = errors.New("time must monotonically increase")
+	ErrTimeTooAdvanced  = errors.New("time is too far advanced")
+
 	errUnexpectedSignature = errors.New("signature provided when none was expected")
 	errInvalidCertificate  = errors.New("invalid certificate")
+	errInvalidBytesLength  = errors.New("invalid bytes length")
+	errIDMismatch          = errors.New("ID doesn't match bytes")
 )
 
 type Block interface {
@@ -27,8 +32,14 @@ type Block interface {
 	Block() []byte
 	Bytes() []byte
 
+	// VerifyID recomputes the ID of the block from its bytes and returns an
+	// error if it doesn't match the ID of the block. This can be used to
+	// detect blocks that were corrupted after being parsed.
+	VerifyID() error
+
 	initialize(bytes []byte) error
 	verify(chainID ids.ID) error
+	innerParent() *innerParentCache
 }
 
 type SignedBlock interface {
@@ -42,6 +53,34 @@ type SignedBlock interface {
 	Proposer() ids.NodeID
 }
 
+// VerifyTimestamp returns nil if the timestamp of [child] isn't before
+// [parentTime] and isn't more than [maxSkew] after [now].
+func VerifyTimestamp(
+	child SignedBlock,
+	parentTime time.Time,
+	now time.Time,
+	maxSkew time.Duration,
+) error {
+	childTime := child.Timestamp()
+	if childTime.Before(parentTime) {
+		return fmt.Errorf("%w: child time %s is before parent time %s",
+			ErrTimeNotMonotonic,
+			childTime,
+			parentTime,
+		)
+	}
+
+	maxTime := now.Add(maxSkew)
+	if childTime.After(maxTime) {
+		return fmt.Errorf("%w: child time %s is after max time %s",
+			ErrTimeTooAdvanced,
+			childTime,
+			maxTime,
+		)
+	}
+	return nil
+}
+
 type statelessUnsignedBlock struct {
 	ParentID     ids.ID `serialize:"true"`
 	Timestamp    int64  `serialize:"true"`
@@ -59,6 +98,8 @@ type statelessBlock struct {
 	cert      *staking.Certificate
 	proposer  ids.NodeID
 	bytes     []byte
+
+	innerParentID innerParentCache
 }
 
 func (b *statelessBlock) ID() ids.ID {
@@ -77,22 +118,30 @@ func (b *statelessBlock) Bytes() []byte {
 	return b.bytes
 }
 
+func (b *statelessBlock) VerifyID() error {
+	id, err := b.computeID(b.bytes)
+	if err != nil {
+		return err
+	}
+	if id != b.id {
+		return fmt.Errorf("%w: expected %s but got %s", errIDMismatch, b.id, id)
+	}
+	return nil
+}
+
 func (b *statelessBlock) initialize(bytes []byte) error {
+	id, err := b.computeID(bytes)
+	if err != nil {
+		return err
+	}
+	b.id = id
 	b.bytes = bytes
 
-	// The serialized form of the block is the unsignedBytes followed by the
-	// signature, which is prefixed by a uint32. So, we need to strip off the
-	// signature as well as it's length prefix to get the unsigned bytes.
-	lenUnsignedBytes := len(bytes) - wrappers.IntLen - len(b.Signature)
-	unsignedBytes := bytes[:lenUnsignedBytes]
-	b.id = hashing.ComputeHash256Array(unsignedBytes)
-
 	b.timestamp = time.Unix(b.StatelessBlock.Timestamp, 0)
 	if len(b.StatelessBlock.Certificate) == 0 {
 		return nil
 	}
 
-	var err error
 	b.cert, err = staking.ParseCertificate(b.StatelessBlock.Certificate)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errInvalidCertificate, err)
@@ -102,6 +151,19 @@ func (b *statelessBlock) initialize(bytes []byte) error {
 	return nil
 }
 
+// computeID returns the hash of the unsigned prefix of [bytes].
+func (b *statelessBlock) computeID(bytes []byte) (ids.ID, error) {
+	// The serialized form of the block is the unsignedBytes followed by the
+	// signature, which is prefixed by a uint32. So, we need to strip off the
+	// signature as well as it's length prefix to get the unsigned bytes.
+	lenUnsignedBytes := len(bytes) - wrappers.IntLen - len(b.Signature)
+	if lenUnsignedBytes < 0 {
+		return ids.Empty, fmt.Errorf("%w: %d", errInvalidBytesLength, len(bytes))
+	}
+	unsignedBytes := bytes[:lenUnsignedBytes]
+	return hashing.ComputeHash256Array(unsignedBytes), nil
+}
+
 func (b *statelessBlock) verify(chainID ids.ID) error {
 	if len(b.StatelessBlock.Certificate) == 0 {
 		if len(b.Signature) > 0 {
@@ -134,3 +196,7 @@ func (b *statelessBlock) Timestamp() time.Time {
 func (b *statelessBlock) Proposer() ids.NodeID {
 	return b.proposer
 }
+
+func (b *statelessBlock) innerParent() *innerParentCache {
+	return &b.innerParentID
+}
@@ -5,13 +5,16 @@ package block
 
 import (
 	"bytes"
+	"crypto"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/staking"
 	"github.com/CaiJiJi/avalanchego/utils/units"
+	"github.com/CaiJiJi/avalanchego/utils/wrappers"
 )
 
 func equal(require *require.Assertions, want, have Block) {
@@ -44,3 +47,124 @@ func TestBlockSizeLimit(t *testing.T) {
 	_, err := BuildUnsigned(parentID, timestamp, pChainHeight, innerBlockBytes)
 	require.NoError(err)
 }
+
+func TestVerifyID(t *testing.T) {
+	parentID := ids.ID{1}
+	timestamp := time.Unix(123, 0)
+	pChainHeight := uint64(2)
+	innerBlockBytes := []byte{3}
+	chainID := ids.ID{4}
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(t, err)
+
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(t, err)
+	key := tlsCert.PrivateKey.(crypto.Signer)
+
+	tests := []struct {
+		name  string
+		build func() (Block, error)
+	}{
+		{
+			name: "signed block",
+			build: func() (Block, error) {
+				return Build(
+					parentID,
+					timestamp,
+					pChainHeight,
+					cert,
+					innerBlockBytes,
+					chainID,
+					key,
+				)
+			},
+		},
+		{
+			name: "unsigned block",
+			build: func() (Block, error) {
+				return BuildUnsigned(
+					parentID,
+					timestamp,
+					pChainHeight,
+					innerBlockBytes,
+				)
+			},
+		},
+		{
+			name: "option",
+			build: func() (Block, error) {
+				return BuildOption(parentID, innerBlockBytes)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			builtBlock, err := test.build()
+			require.NoError(err)
+
+			parsedBlock, err := ParseWithoutVerification(builtBlock.Bytes())
+			require.NoError(err)
+			require.NoError(parsedBlock.VerifyID())
+
+			// Corrupt the first byte of the parent ID, which follows the codec
+			// version and the type ID.
+			parsedBlock.Bytes()[wrappers.ShortLen+wrappers.IntLen] ^= 0xff
+
+			err = parsedBlock.VerifyID()
+			require.ErrorIs(err, errIDMismatch)
+		})
+	}
+}
+
+func TestVerifyTimestamp(t *testing.T) {
+	var (
+		now        = time.Unix(1000, 0)
+		parentTime = now.Add(-time.Minute)
+		maxSkew    = 10 * time.Second
+	)
+	tests := []struct {
+		name        string
+		childTime   time.Time
+		expectedErr error
+	}{
+		{
+			name:        "equal to parent",
+			childTime:   parentTime,
+			expectedErr: nil,
+		},
+		{
+			name:        "after parent",
+			childTime:   parentTime.Add(time.Second),
+			expectedErr: nil,
+		},
+		{
+			name:        "before parent",
+			childTime:   parentTime.Add(-time.Second),
+			expectedErr: ErrTimeNotMonotonic,
+		},
+		{
+			name:        "at max skew",
+			childTime:   now.Add(maxSkew),
+			expectedErr: nil,
+		},
+		{
+			name:        "beyond max skew",
+			childTime:   now.Add(maxSkew + time.Second),
+			expectedErr: ErrTimeTooAdvanced,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			child, err := BuildUnsigned(ids.ID{1}, test.childTime, 2, []byte{3})
+			require.NoError(err)
+
+			err = VerifyTimestamp(child, parentTime, now, maxSkew)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
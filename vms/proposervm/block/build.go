@@ -6,6 +6,7 @@ package block
 import (
 	"crypto"
 	"crypto/rand"
+	"errors"
 	"time"
 
 	"github.com/CaiJiJi/avalanchego/ids"
@@ -14,12 +15,21 @@ import (
 	"github.com/CaiJiJi/avalanchego/utils/wrappers"
 )
 
+var errEmptyInnerBlock = errors.New("empty inner block")
+
+// BuildUnsigned builds a block with no certificate or signature, as is done
+// before the proposer windows have started or when the proposer windows have
+// all passed.
 func BuildUnsigned(
 	parentID ids.ID,
 	timestamp time.Time,
 	pChainHeight uint64,
 	blockBytes []byte,
 ) (SignedBlock, error) {
+	if len(blockBytes) == 0 {
+		return nil, errEmptyInnerBlock
+	}
+
 	var block SignedBlock = &statelessBlock{
 		StatelessBlock: statelessUnsignedBlock{
 			ParentID:     parentID,
@@ -65,6 +65,21 @@ func TestBuildUnsigned(t *testing.T) {
 	require.Equal(timestamp, builtBlock.Timestamp())
 	require.Equal(innerBlockBytes, builtBlock.Block())
 	require.Equal(ids.EmptyNodeID, builtBlock.Proposer())
+
+	chainID := ids.ID{4}
+	parsedBlockIntf, err := Parse(builtBlock.Bytes(), chainID)
+	require.NoError(err)
+	require.IsType(&statelessBlock{}, parsedBlockIntf)
+	parsedBlock := parsedBlockIntf.(*statelessBlock)
+
+	require.Equal(builtBlock.ID(), parsedBlock.ID())
+	require.Equal(builtBlock.Bytes(), parsedBlock.Bytes())
+	require.Equal(ids.EmptyNodeID, parsedBlock.Proposer())
+	require.Empty(parsedBlock.Signature)
+	require.NoError(parsedBlock.verify(chainID))
+
+	_, err = BuildUnsigned(parentID, timestamp, pChainHeight, nil)
+	require.ErrorIs(err, errEmptyInnerBlock)
 }
 
 func TestBuildHeader(t *testing.T) {
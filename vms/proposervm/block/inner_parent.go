@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"errors"
+
+	"github.com/CaiJiJi/avalanchego/ids"
+)
+
+var errNoInnerParentParser = errors.New("no inner parent parser provided")
+
+// InnerParentParser returns the parent ID of the inner block serialized in
+// [innerBlockBytes]. Implementations should only decode as much of the inner
+// block as is needed to find its parent.
+type InnerParentParser func(innerBlockBytes []byte) (ids.ID, error)
+
+// InnerParentID returns the parent ID of the inner block wrapped by [blk], as
+// extracted by [parse]. The result is cached on [blk], so [parse] is only
+// called once per block.
+//
+// InnerParentID is not safe for concurrent use with the same [blk].
+func InnerParentID(blk Block, parse InnerParentParser) (ids.ID, error) {
+	if parse == nil {
+		return ids.Empty, errNoInnerParentParser
+	}
+
+	cache := blk.innerParent()
+	if cache.parsed {
+		return cache.id, nil
+	}
+
+	id, err := parse(blk.Block())
+	if err != nil {
+		return ids.Empty, err
+	}
+	cache.id = id
+	cache.parsed = true
+	return id, nil
+}
+
+// innerParentCache holds the parent ID of an inner block once it has been
+// extracted.
+type innerParentCache struct {
+	id     ids.ID
+	parsed bool
+}
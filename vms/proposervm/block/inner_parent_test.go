@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CaiJiJi/avalanchego/ids"
+)
+
+var errShortInnerBlock = errors.New("inner block too short")
+
+func TestInnerParentID(t *testing.T) {
+	require := require.New(t)
+
+	// The test inner blocks are serialized as their parent ID followed by
+	// their payload.
+	innerParentID := ids.ID{1}
+	innerBlockBytes := append(innerParentID[:], 2, 3)
+
+	numParsed := 0
+	parse := func(blkBytes []byte) (ids.ID, error) {
+		numParsed++
+		if len(blkBytes) < ids.IDLen {
+			return ids.Empty, errShortInnerBlock
+		}
+		return ids.ToID(blkBytes[:ids.IDLen])
+	}
+
+	signedBlock, err := BuildUnsigned(ids.ID{4}, time.Unix(123, 0), 5, innerBlockBytes)
+	require.NoError(err)
+	optionBlock, err := BuildOption(ids.ID{6}, innerBlockBytes)
+	require.NoError(err)
+
+	for _, blk := range []Block{signedBlock, optionBlock} {
+		_, err := InnerParentID(blk, nil)
+		require.ErrorIs(err, errNoInnerParentParser)
+
+		parsedBlk, err := ParseWithoutVerification(blk.Bytes())
+		require.NoError(err)
+
+		numParsed = 0
+		for i := 0; i < 2; i++ {
+			parentID, err := InnerParentID(parsedBlk, parse)
+			require.NoError(err)
+			require.Equal(innerParentID, parentID)
+		}
+		require.Equal(1, numParsed)
+	}
+
+	malformedBlock, err := BuildOption(ids.ID{6}, []byte{7})
+	require.NoError(err)
+	_, err = InnerParentID(malformedBlock, parse)
+	require.ErrorIs(err, errShortInnerBlock)
+}
@@ -4,6 +4,8 @@
 package block
 
 import (
+	"fmt"
+
 	"github.com/CaiJiJi/avalanchego/ids"
 	"github.com/CaiJiJi/avalanchego/utils/hashing"
 )
@@ -14,6 +16,8 @@ type option struct {
 
 	id    ids.ID
 	bytes []byte
+
+	innerParentID innerParentCache
 }
 
 func (b *option) ID() ids.ID {
@@ -32,6 +36,14 @@ func (b *option) Bytes() []byte {
 	return b.bytes
 }
 
+func (b *option) VerifyID() error {
+	id := hashing.ComputeHash256Array(b.bytes)
+	if id != b.id {
+		return fmt.Errorf("%w: expected %s but got %s", errIDMismatch, b.id, id)
+	}
+	return nil
+}
+
 func (b *option) initialize(bytes []byte) error {
 	b.id = hashing.ComputeHash256Array(bytes)
 	b.bytes = bytes
@@ -41,3 +53,7 @@ func (b *option) initialize(bytes []byte) error {
 func (*option) verify(ids.ID) error {
 	return nil
 }
+
+func (b *option) innerParent() *innerParentCache {
+	return &b.innerParentID
+}
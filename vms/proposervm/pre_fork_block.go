@@ -136,16 +136,10 @@ func (b *preForkBlock) verifyPostForkChild(ctx context.Context, child *postForkB
 		return errProposersNotActivated
 	}
 
-	// Child's timestamp must be at or after its parent's timestamp
-	childTimestamp := child.Timestamp()
-	if childTimestamp.Before(parentTimestamp) {
-		return errTimeNotMonotonic
-	}
-
-	// Child timestamp can't be too far in the future
-	maxTimestamp := b.vm.Time().Add(maxSkew)
-	if childTimestamp.After(maxTimestamp) {
-		return errTimeTooAdvanced
+	// Child's timestamp must be at or after its parent's timestamp and can't be
+	// too far in the future
+	if err := block.VerifyTimestamp(child.SignedBlock, parentTimestamp, b.vm.Time(), maxSkew); err != nil {
+		return err
 	}
 
 	// Verify the lack of signature on the node
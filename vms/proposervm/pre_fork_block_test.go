@@ -359,6 +359,46 @@ func TestBlockVerify_BlocksBuiltOnPreForkGenesis(t *testing.T) {
 	require.NoError(firstPostForkBlk.Verify(context.Background()))
 }
 
+func TestBlockVerify_PostForkChildOfPreForkBlock_PChainHeightNotReached(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = snowmantest.GenesisTimestamp
+		durangoTime    = activationTime
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	coreBlk := snowmantest.BuildChild(snowmantest.Genesis)
+	currentPChainHeight, err := proVM.ctx.ValidatorState.GetCurrentHeight(context.Background())
+	require.NoError(err)
+
+	// The first post fork block can't reference a P-chain height that the node
+	// hasn't reached yet.
+	postForkStatelessChild, err := statelessblock.Build(
+		snowmantest.GenesisID,
+		coreBlk.Timestamp(),
+		currentPChainHeight+1,
+		proVM.StakingCertLeaf,
+		coreBlk.Bytes(),
+		proVM.ctx.ChainID,
+		proVM.StakingLeafSigner,
+	)
+	require.NoError(err)
+	postForkChild := &postForkBlock{
+		SignedBlock: postForkStatelessChild,
+		postForkCommonComponents: postForkCommonComponents{
+			vm:       proVM,
+			innerBlk: coreBlk,
+		},
+	}
+
+	err = postForkChild.Verify(context.Background())
+	require.ErrorIs(err, errPChainHeightNotReached)
+}
+
 func TestBlockVerify_BlocksBuiltOnPostForkGenesis(t *testing.T) {
 	require := require.New(t)
 
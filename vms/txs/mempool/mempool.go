@@ -10,6 +10,7 @@ import (
 
 	"github.com/CaiJiJi/avalanchego/cache"
 	"github.com/CaiJiJi/avalanchego/ids"
+	"github.com/CaiJiJi/avalanchego/utils"
 	"github.com/CaiJiJi/avalanchego/utils/linked"
 	"github.com/CaiJiJi/avalanchego/utils/set"
 	"github.com/CaiJiJi/avalanchego/utils/setmap"
@@ -48,6 +49,9 @@ type Metrics interface {
 type Mempool[T Tx] interface {
 	Add(tx T) error
 	Get(txID ids.ID) (T, bool)
+	// GetSpender returns the tx in the mempool that consumes [inputID], if
+	// any. Because conflicting txs are not added, there is at most one.
+	GetSpender(inputID ids.ID) (T, bool)
 	// Remove [txs] and any conflicts of [txs] from the mempool.
 	Remove(txs ...T)
 
@@ -148,6 +152,17 @@ func (m *mempool[T]) Get(txID ids.ID) (T, bool) {
 	return m.unissuedTxs.Get(txID)
 }
 
+func (m *mempool[T]) GetSpender(inputID ids.ID) (T, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	txID, ok := m.consumedUTXOs.GetKey(inputID)
+	if !ok {
+		return utils.Zero[T](), false
+	}
+	return m.unissuedTxs.Get(txID)
+}
+
 func (m *mempool[T]) Remove(txs ...T) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
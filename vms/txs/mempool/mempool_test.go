@@ -135,6 +135,38 @@ func TestGet(t *testing.T) {
 	require.False(exists)
 }
 
+func TestGetSpender(t *testing.T) {
+	require := require.New(t)
+
+	mempool := newMempool()
+
+	tx := newTx(0, 32)
+	inputID := ids.Empty.Prefix(0)
+
+	_, exists := mempool.GetSpender(inputID)
+	require.False(exists)
+
+	require.NoError(mempool.Add(tx))
+
+	returned, exists := mempool.GetSpender(inputID)
+	require.True(exists)
+	require.Equal(tx, returned)
+
+	// A conflicting tx is never added, so it can't be reported as a spender.
+	txConflict := newTx(0, 32)
+	err := mempool.Add(txConflict)
+	require.ErrorIs(err, ErrConflictsWithOtherTx)
+
+	returned, exists = mempool.GetSpender(inputID)
+	require.True(exists)
+	require.Equal(tx, returned)
+
+	mempool.Remove(tx)
+
+	_, exists = mempool.GetSpender(inputID)
+	require.False(exists)
+}
+
 func TestPeek(t *testing.T) {
 	require := require.New(t)
 
@@ -22,8 +22,10 @@ import (
 )
 
 var (
-	errNoChangeAddress   = errors.New("no possible change address")
-	errInsufficientFunds = errors.New("insufficient funds")
+	ErrZeroThreshold         = errors.New("threshold must be positive when addresses are provided")
+	ErrThresholdExceedsAddrs = errors.New("threshold exceeds the number of addresses")
+	errNoChangeAddress       = errors.New("no possible change address")
+	errInsufficientFunds     = errors.New("insufficient funds")
 
 	fxIndexToID = map[uint32]ids.ID{
 		SECP256K1FxIndex: secp256k1fx.ID,
@@ -208,6 +210,10 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	if err := verifyOutputOwners(outputs); err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -366,6 +372,10 @@ func (b *builder) NewImportTx(
 	to *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.ImportTx, error) {
+	if err := verifyOwner(to); err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+
 	ops := common.NewOptions(options)
 	utxos, err := b.backend.UTXOs(ops.Context(), chainID)
 	if err != nil {
@@ -475,6 +485,10 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	if err := verifyOutputOwners(outputs); err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -572,6 +586,9 @@ func (b *builder) spend(
 		Threshold: 1,
 		Addrs:     []ids.ShortID{addr},
 	})
+	if err := verifyOwner(changeOwner); err != nil {
+		return nil, nil, fmt.Errorf("invalid change owner: %w", err)
+	}
 
 	// Iterate over the UTXOs
 	for _, utxo := range utxos {
@@ -651,6 +668,12 @@ func (b *builder) mintFTs(
 	operations []*txs.Operation,
 	err error,
 ) {
+	for assetID, output := range outputs {
+		if err := verifyOwner(&output.OutputOwners); err != nil {
+			return nil, fmt.Errorf("invalid owner of asset %q: %w", assetID, err)
+		}
+	}
+
 	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
 	if err != nil {
 		return nil, err
@@ -714,6 +737,12 @@ func (b *builder) mintNFTs(
 	operations []*txs.Operation,
 	err error,
 ) {
+	for i, owner := range owners {
+		if err := verifyOwner(owner); err != nil {
+			return nil, fmt.Errorf("invalid owner %d: %w", i, err)
+		}
+	}
+
 	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
 	if err != nil {
 		return nil, err
@@ -771,6 +800,10 @@ func (b *builder) mintProperty(
 	operations []*txs.Operation,
 	err error,
 ) {
+	if err := verifyOwner(owner); err != nil {
+		return nil, fmt.Errorf("invalid owner: %w", err)
+	}
+
 	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
 	if err != nil {
 		return nil, err
@@ -889,3 +922,33 @@ func (b *builder) initCtx(tx txs.UnsignedTx) error {
 	tx.InitCtx(ctx)
 	return nil
 }
+
+// verifyOutputOwners verifies the owners of the secp256k1fx outputs in
+// [outputs]. Outputs of other types are left to be verified by their fx.
+func verifyOutputOwners(outputs []*avax.TransferableOutput) error {
+	for i, output := range outputs {
+		out, ok := output.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		if err := verifyOwner(&out.OutputOwners); err != nil {
+			return fmt.Errorf("invalid owner of output %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// verifyOwner returns an error if [owner] could never be satisfied, so that
+// the mistake is reported before the tx is built rather than when it is
+// verified.
+func verifyOwner(owner *secp256k1fx.OutputOwners) error {
+	numAddrs := len(owner.Addrs)
+	switch {
+	case owner.Threshold == 0 && numAddrs > 0:
+		return fmt.Errorf("%w: threshold = 0, addresses = %d", ErrZeroThreshold, numAddrs)
+	case int(owner.Threshold) > numAddrs:
+		return fmt.Errorf("%w: threshold = %d, addresses = %d", ErrThresholdExceedsAddrs, owner.Threshold, numAddrs)
+	default:
+		return nil
+	}
+}
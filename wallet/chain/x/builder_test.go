@@ -19,6 +19,7 @@ import (
 	"github.com/CaiJiJi/avalanchego/vms/propertyfx"
 	"github.com/CaiJiJi/avalanchego/vms/secp256k1fx"
 	"github.com/CaiJiJi/avalanchego/wallet/chain/x/builder"
+	"github.com/CaiJiJi/avalanchego/wallet/subnet/primary/common"
 	"github.com/CaiJiJi/avalanchego/wallet/subnet/primary/common/utxotest"
 )
 
@@ -456,6 +457,103 @@ func TestExportTx(t *testing.T) {
 	require.Equal(utx.ExportedOuts, exportedOutputs)
 }
 
+func TestInvalidOwnerThreshold(t *testing.T) {
+	var (
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = utxotest.NewDeterministicChainUTXOs(
+			t,
+			map[ids.ID][]*avax.UTXO{
+				xChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		validOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+		twoOfOneOwner = &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+		zeroOfOneOwner = &secp256k1fx.OutputOwners{
+			Threshold: 0,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+		newOutputs = func(owner *secp256k1fx.OutputOwners) []*avax.TransferableOutput {
+			return []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          7 * units.Avax,
+					OutputOwners: *owner,
+				},
+			}}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		build       func() error
+		expectedErr error
+	}{
+		{
+			name: "2-of-1 recipient",
+			build: func() error {
+				_, err := txBuilder.NewBaseTx(newOutputs(twoOfOneOwner))
+				return err
+			},
+			expectedErr: builder.ErrThresholdExceedsAddrs,
+		},
+		{
+			name: "0-of-1 recipient",
+			build: func() error {
+				_, err := txBuilder.NewBaseTx(newOutputs(zeroOfOneOwner))
+				return err
+			},
+			expectedErr: builder.ErrZeroThreshold,
+		},
+		{
+			name: "2-of-1 change owner",
+			build: func() error {
+				_, err := txBuilder.NewBaseTx(
+					newOutputs(validOwner),
+					common.WithChangeOwner(twoOfOneOwner),
+				)
+				return err
+			},
+			expectedErr: builder.ErrThresholdExceedsAddrs,
+		},
+		{
+			name: "2-of-1 import recipient",
+			build: func() error {
+				_, err := txBuilder.NewImportTx(ids.GenerateTestID(), twoOfOneOwner)
+				return err
+			},
+			expectedErr: builder.ErrThresholdExceedsAddrs,
+		},
+		{
+			name: "2-of-1 property owner",
+			build: func() error {
+				_, err := txBuilder.NewOperationTxMintProperty(propertyAssetID, twoOfOneOwner)
+				return err
+			},
+			expectedErr: builder.ErrThresholdExceedsAddrs,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.build()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func makeTestUTXOs(utxosKey *secp256k1.PrivateKey) []*avax.UTXO {
 	// Note: we avoid ids.GenerateTestNodeID here to make sure that UTXO IDs won't change
 	// run by run. This simplifies checking what utxos are included in the built txs.